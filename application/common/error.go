@@ -21,4 +21,6 @@ const (
 	ErrorInternalServer tError = iota + 1000
 	ErrorBadRequest
 	ErrorNotFound
+	ErrorForbidden
+	ErrorConflict
 )
@@ -0,0 +1,21 @@
+package common
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+)
+
+type tenantIdKey struct{}
+
+// TenantId returns the tenant the request acts on, the caller's own tenant unless SetTenantId picked another one.
+func TenantId(ctx *contextplus.Context) uuid.UUID {
+	if tenantId, ok := ctx.Value(tenantIdKey{}).(uuid.UUID); ok {
+		return tenantId
+	}
+	return ctx.User.Id()
+}
+
+// SetTenantId makes the request act on tenantId, callers set it only once the caller's role in that tenant is checked.
+func SetTenantId(ctx *contextplus.Context, tenantId uuid.UUID) {
+	ctx.SetValue(tenantIdKey{}, tenantId)
+}
@@ -0,0 +1,11 @@
+package commands
+
+type SApiKeyAuthenticateCommand struct {
+	key string
+}
+
+func NewApiKeyAuthenticateCommand(key string) SApiKeyAuthenticateCommand {
+	return SApiKeyAuthenticateCommand{
+		key: key,
+	}
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"time"
+)
+
+// _apiKeyLastUsedResolution bounds how often authenticating with the same key writes its last used timestamp.
+const _apiKeyLastUsedResolution = time.Minute
+
+type SApiKeyAuthenticateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newApiKeyAuthenticateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SApiKeyAuthenticateCommandHandler {
+	return SApiKeyAuthenticateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SApiKeyAuthenticateCommandHandler) Handle(ctx *contextplus.Context, command SApiKeyAuthenticateCommand) (*entities.ApiKey, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	apiKey, err := r.iUnitOfWork.ApiKeyRepository().FirstOrDefault(ctx, genericRepository.Equal("key_hash", entities.HashApiKey(command.key)))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in find api key")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	now := time.Now()
+	if apiKey == nil || apiKey.IsExpired(now) {
+		return nil, common.ErrorNotFound
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= _apiKeyLastUsedResolution {
+		if _, err = r.iUnitOfWork.ApiKeyRepository().UpdateColumn(ctx, "last_used_at", now, genericRepository.Equal("id", apiKey.Id)); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", apiKey.Id).Error(ctx, "error in update api key last used at")
+		}
+		apiKey.LastUsedAt = &now
+	}
+
+	return apiKey, nil
+}
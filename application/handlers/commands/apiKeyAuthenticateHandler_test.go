@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"testing"
+	"time"
+)
+
+func TestApiKeyAuthenticateHandle(t *testing.T) {
+	const key = "hck_0123456789abcdef"
+	recently := time.Now().Add(-10 * time.Second)
+	stale := time.Now().Add(-time.Hour)
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	tableTests := []struct {
+		name          string
+		apiKey        *entities.ApiKey
+		findErr       error
+		updatesLastAt bool
+		err           error
+	}{
+		{
+			name: "unknown key",
+			err:  common.ErrorNotFound,
+		},
+		{
+			name:    "repository fails",
+			findErr: errors.New("connection refused"),
+			err:     common.ErrorInternalServer,
+		},
+		{
+			name:   "expired key",
+			apiKey: &entities.ApiKey{Id: 1, TenantId: uuid.New(), ExpiresAt: &past},
+			err:    common.ErrorNotFound,
+		},
+		{
+			name:          "first use",
+			apiKey:        &entities.ApiKey{Id: 1, TenantId: uuid.New(), ExpiresAt: &future},
+			updatesLastAt: true,
+		},
+		{
+			name:          "stale last used at",
+			apiKey:        &entities.ApiKey{Id: 1, TenantId: uuid.New(), LastUsedAt: &stale},
+			updatesLastAt: true,
+		},
+		{
+			name:   "recently used",
+			apiKey: &entities.ApiKey{Id: 1, TenantId: uuid.New(), LastUsedAt: &recently},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			apiKeyAuthenticateCommandHandler := newApiKeyAuthenticateCommandHandler(mock.iLogger, mock.iTracer, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+
+			mock.expectSpan(ctx, 1)
+			mock.iUnitOfWork.EXPECT().ApiKeyRepository().Return(mock.iApiKeyRepository).MinTimes(1)
+			mock.iApiKeyRepository.EXPECT().FirstOrDefault(ctx, genericRepository.Equal("key_hash", entities.HashApiKey(key))).Return(tableTest.apiKey, tableTest.findErr).Times(1)
+			if tableTest.findErr != nil {
+				mock.iSpan.EXPECT().SetTag("error", true).Times(1)
+				mock.iSpan.EXPECT().LogKV("err", tableTest.findErr).Times(1)
+				mock.iLogger.EXPECT().WithError(tableTest.findErr).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().Error(ctx, "error in find api key").Times(1)
+			}
+			if tableTest.updatesLastAt {
+				mock.iApiKeyRepository.EXPECT().UpdateColumn(ctx, "last_used_at", gomock.Any(), genericRepository.Equal("id", tableTest.apiKey.Id)).Return(tableTest.apiKey, nil).Times(1)
+			}
+
+			apiKey, err := apiKeyAuthenticateCommandHandler.Handle(ctx, NewApiKeyAuthenticateCommand(key))
+
+			assert.Equal(t, tableTest.err, err)
+			if tableTest.err != nil {
+				assert.Nil(t, apiKey)
+				return
+			}
+			assert.Equal(t, tableTest.apiKey.TenantId, apiKey.TenantId)
+			assert.NotNil(t, apiKey.LastUsedAt)
+		})
+	}
+}
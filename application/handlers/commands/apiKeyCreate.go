@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+	"time"
+)
+
+type SApiKeyCreateCommand struct {
+	tenantId  uuid.UUID
+	name      string
+	scopes    []enums.Permission
+	expiresAt *time.Time
+	createdBy uuid.UUID
+}
+
+func NewApiKeyCreateCommand(tenantId uuid.UUID, name string, scopes []enums.Permission, expiresAt *time.Time, createdBy uuid.UUID) SApiKeyCreateCommand {
+	return SApiKeyCreateCommand{
+		tenantId:  tenantId,
+		name:      name,
+		scopes:    scopes,
+		expiresAt: expiresAt,
+		createdBy: createdBy,
+	}
+}
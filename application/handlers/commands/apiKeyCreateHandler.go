@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/tracer"
+	"time"
+)
+
+type SApiKeyCreateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newApiKeyCreateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SApiKeyCreateCommandHandler {
+	return SApiKeyCreateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SApiKeyCreateCommandHandler) Handle(ctx *contextplus.Context, command SApiKeyCreateCommand) (*entities.ApiKey, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if command.expiresAt != nil && !command.expiresAt.After(time.Now()) {
+		return nil, common.ErrorBadRequest
+	}
+
+	apiKey, err := entities.NewApiKey(command.tenantId, command.name, command.scopes, command.expiresAt, command.createdBy)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in generate api key")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if err = iUnitOfWork.ApiKeyRepository().Create(ctx, &apiKey); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in create new api key")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionCreate, "apiKey", apiKey.Id, nil, apiKey); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
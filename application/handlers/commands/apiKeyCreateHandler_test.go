@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApiKeyCreateHandle(t *testing.T) {
+	tenantId := uuid.New()
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	scopes := []enums.Permission{enums.PermissionList, enums.PermissionStatus}
+
+	tableTests := []struct {
+		name      string
+		expiresAt *time.Time
+		err       error
+	}{
+		{
+			name:      "expires in the past",
+			expiresAt: &past,
+			err:       common.ErrorBadRequest,
+		},
+		{
+			name: "never expires",
+		},
+		{
+			name:      "expires in the future",
+			expiresAt: &future,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			apiKeyCreateCommandHandler := newApiKeyCreateCommandHandler(mock.iLogger, mock.iTracer, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+			ctx.User.SetId(tenantId)
+
+			mock.expectSpan(ctx, 1)
+			var stored *entities.ApiKey
+			if tableTest.err == nil {
+				mock.expectDo(ctx)
+				mock.iUnitOfWork.EXPECT().ApiKeyRepository().Return(mock.iApiKeyRepository).Times(1)
+				mock.iApiKeyRepository.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, apiKey *entities.ApiKey) error {
+					stored = apiKey
+					apiKey.Id = 1
+					return nil
+				}).Times(1)
+				mock.expectAudit(ctx, func(auditLog *entities.AuditLog) {
+					assert.Equal(t, enums.AuditActionCreate, auditLog.Action)
+					assert.Equal(t, "apiKey", auditLog.Entity)
+					assert.Equal(t, uint(1), auditLog.EntityId)
+					assert.Nil(t, auditLog.Before)
+					assert.NotContains(t, string(auditLog.After), stored.Key)
+					assert.NotContains(t, string(auditLog.After), stored.KeyHash)
+				})
+			}
+
+			apiKey, err := apiKeyCreateCommandHandler.Handle(ctx, NewApiKeyCreateCommand(tenantId, "ci", scopes, tableTest.expiresAt, tenantId))
+
+			assert.Equal(t, tableTest.err, err)
+			if tableTest.err != nil {
+				return
+			}
+			assert.True(t, strings.HasPrefix(apiKey.Key, "hck_"))
+			assert.Equal(t, entities.HashApiKey(apiKey.Key), stored.KeyHash)
+			assert.NotEqual(t, apiKey.Key, stored.KeyHash)
+			assert.Equal(t, apiKey.Key[:12], apiKey.Prefix)
+			assert.Equal(t, scopes, apiKey.Scopes.Data())
+			assert.True(t, apiKey.Allows(enums.PermissionStatus))
+			assert.False(t, apiKey.Allows(enums.PermissionDelete))
+		})
+	}
+}
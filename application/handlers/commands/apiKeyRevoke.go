@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SApiKeyRevokeCommand struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewApiKeyRevokeCommand(tenantId uuid.UUID, id uint) SApiKeyRevokeCommand {
+	return SApiKeyRevokeCommand{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
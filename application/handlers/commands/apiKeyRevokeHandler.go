@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SApiKeyRevokeCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newApiKeyRevokeCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SApiKeyRevokeCommandHandler {
+	return SApiKeyRevokeCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SApiKeyRevokeCommandHandler) Handle(ctx *contextplus.Context, command SApiKeyRevokeCommand) (apiKey *entities.ApiKey, err error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if apiKey, err = iUnitOfWork.ApiKeyRepository().SingleOrDefault(
+			ctx,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in find api key")
+
+			return common.ErrorInternalServer
+		}
+
+		if apiKey == nil {
+			return common.ErrorNotFound
+		}
+
+		before := *apiKey
+
+		if apiKey, err = iUnitOfWork.ApiKeyRepository().Delete(
+			ctx,
+			apiKey,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in revoke api key")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionDelete, "apiKey", before.Id, before, nil); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"encoding/json"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+)
+
+// audit records a change made by the current user, before and after are marshalled as they are at call time
+// and a nil one is stored as NULL, the headers and the body of a health check are redacted first.
+func audit(ctx *contextplus.Context, iUnitOfWork interfaces.IUnitOfWork, tenantId uuid.UUID, action enums.AuditAction, entity string, entityId uint, before any, after any) error {
+	var beforeJson, afterJson datatypes.JSON
+	if before != nil {
+		payload, err := json.Marshal(snapshot(before))
+		if err != nil {
+			return err
+		}
+		beforeJson = payload
+	}
+	if after != nil {
+		payload, err := json.Marshal(snapshot(after))
+		if err != nil {
+			return err
+		}
+		afterJson = payload
+	}
+
+	auditLog := entities.NewAuditLog(tenantId, ctx.User.Id(), ctx.RequestId(), action, entity, entityId, beforeJson, afterJson)
+	return iUnitOfWork.AuditLogRepository().Create(ctx, &auditLog)
+}
+
+// snapshot redacts the values of the headers and the body of a health check, they may carry the credentials of the
+// probe and the audit log keeps every snapshot for good.
+func snapshot(value any) any {
+	switch value := value.(type) {
+	case *entities.HealthCheck:
+		return snapshot(*value)
+	case entities.HealthCheck:
+		value.Headers = datatypes.NewJSONType(valueObjects.RedactHeaders(value.Headers.Data()))
+		value.Body = datatypes.NewJSONType(valueObjects.RedactBody(value.Body.Data()))
+		return value
+	default:
+		return value
+	}
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"encoding/json"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"testing"
+)
+
+func TestAudit(t *testing.T) {
+	tenantId := uuid.New()
+	actorId := uuid.New()
+	healthCheck := func() *entities.HealthCheck {
+		return &entities.HealthCheck{
+			Id:       1,
+			TenantId: tenantId,
+			Url:      "https://example.com",
+			Headers:  datatypes.NewJSONType(map[string]string{"Authorization": "Bearer token", "X-Token": `{{ secret "token" }}`}),
+			Body:     datatypes.NewJSONType(map[string]any{"password": "hunter2", "retries": float64(3)}),
+		}
+	}
+
+	tableTests := []struct {
+		name   string
+		action enums.AuditAction
+		before any
+		after  any
+	}{
+		{
+			name:   "create stores a NULL before",
+			action: enums.AuditActionCreate,
+			after:  healthCheck(),
+		},
+		{
+			name:   "delete stores a NULL after",
+			action: enums.AuditActionDelete,
+			before: healthCheck(),
+		},
+		{
+			name:   "update redacts both snapshots",
+			action: enums.AuditActionUpdate,
+			before: *healthCheck(),
+			after:  healthCheck(),
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			ctx := contextplus.Background()
+			ctx.User.SetId(actorId)
+			ctx.SetRequestId("request-id")
+
+			mock.expectAudit(ctx, func(auditLog *entities.AuditLog) {
+				assert.Equal(t, tenantId, auditLog.TenantId)
+				assert.Equal(t, actorId, auditLog.ActorId)
+				assert.Equal(t, "request-id", auditLog.RequestId)
+				assert.Equal(t, tableTest.action, auditLog.Action)
+				assert.Equal(t, "healthCheck", auditLog.Entity)
+				assert.Equal(t, uint(1), auditLog.EntityId)
+				for field, value := range map[string]any{"before": tableTest.before, "after": tableTest.after} {
+					payload := auditLog.Before
+					if field == "after" {
+						payload = auditLog.After
+					}
+					if value == nil {
+						assert.Nil(t, payload, field)
+						continue
+					}
+					var stored entities.HealthCheck
+					assert.NoError(t, json.Unmarshal(payload, &stored), field)
+					assert.Equal(t, "https://example.com", stored.Url, field)
+					assert.Equal(t, map[string]string{"Authorization": "***", "X-Token": `{{ secret "token" }}`}, stored.Headers.Data(), field)
+					assert.Equal(t, map[string]any{"password": "***", "retries": float64(3)}, stored.Body.Data(), field)
+				}
+			})
+
+			err := audit(ctx, mock.iUnitOfWork, tenantId, tableTest.action, "healthCheck", 1, tableTest.before, tableTest.after)
+
+			assert.NoError(t, err)
+			for _, value := range []any{tableTest.before, tableTest.after} {
+				if stored, ok := value.(*entities.HealthCheck); ok {
+					assert.Equal(t, "Bearer token", stored.Headers.Data()["Authorization"])
+				}
+			}
+		})
+	}
+}
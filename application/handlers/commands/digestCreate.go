@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+)
+
+type SDigestCreateCommand struct {
+	tenantId              uuid.UUID
+	notificationChannelId uint
+	schedule              string
+	period                enums.DigestPeriod
+	tag                   *string
+	labels                map[string]string
+}
+
+func NewDigestCreateCommand(tenantId uuid.UUID, notificationChannelId uint, schedule string, period enums.DigestPeriod, tag *string, labels map[string]string) SDigestCreateCommand {
+	return SDigestCreateCommand{
+		tenantId:              tenantId,
+		notificationChannelId: notificationChannelId,
+		schedule:              schedule,
+		period:                period,
+		tag:                   tag,
+		labels:                labels,
+	}
+}
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"encoding/json"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SDigestCreateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iCron       interfaces.ICron
+	iRedis      interfaces.IRedis
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newDigestCreateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iCron interfaces.ICron,
+	iRedis interfaces.IRedis,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SDigestCreateCommandHandler {
+	return SDigestCreateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iCron:       iCron,
+		iRedis:      iRedis,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SDigestCreateCommandHandler) Handle(ctx *contextplus.Context, command SDigestCreateCommand) (*entities.Digest, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := r.iCron.Validate(command.schedule); err != nil {
+		return nil, common.ErrorBadRequest
+	}
+
+	digest := entities.NewDigest(command.tenantId, command.notificationChannelId, command.schedule, command.period, command.tag, command.labels)
+	if err := r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		exists, err := iUnitOfWork.NotificationChannelRepository().Exists(ctx, genericRepository.Equal("id", command.notificationChannelId), genericRepository.Equal("tenant_id", command.tenantId))
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find notification channel")
+
+			return common.ErrorInternalServer
+		}
+
+		if !exists {
+			return common.ErrorBadRequest
+		}
+
+		if err = iUnitOfWork.DigestRepository().Create(ctx, &digest); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).WithAny("digest", digest).Error(ctx, "error in create new digest")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionCreate, "digest", digest.Id, nil, digest); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		payload, err := json.Marshal(digest)
+		if err != nil {
+			return common.ErrorInternalServer
+		}
+
+		if err = r.iRedis.Publish(ctx, "digest", payload); err != nil {
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &digest, nil
+}
@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SDigestDeleteCommand struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewDigestDeleteCommand(tenantId uuid.UUID, id uint) SDigestDeleteCommand {
+	return SDigestDeleteCommand{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"encoding/json"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SDigestDeleteCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iRedis      interfaces.IRedis
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newDigestDeleteCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iRedis interfaces.IRedis,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SDigestDeleteCommandHandler {
+	return SDigestDeleteCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iRedis:      iRedis,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SDigestDeleteCommandHandler) Handle(ctx *contextplus.Context, command SDigestDeleteCommand) (digest *entities.Digest, err error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if digest, err = iUnitOfWork.DigestRepository().SingleOrDefault(
+			ctx,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in find digest")
+
+			return common.ErrorInternalServer
+		}
+
+		if digest == nil {
+			return common.ErrorNotFound
+		}
+
+		before := *digest
+
+		if digest, err = iUnitOfWork.DigestRepository().Delete(
+			ctx,
+			digest,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in delete digest")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionDelete, "digest", before.Id, before, nil); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		var payload []byte
+		if payload, err = json.Marshal(digest); err != nil {
+			return common.ErrorInternalServer
+		}
+
+		if err = r.iRedis.Publish(ctx, "digest", payload); err != nil {
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return digest, nil
+}
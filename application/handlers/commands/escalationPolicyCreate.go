@@ -0,0 +1,20 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/valueObjects"
+)
+
+type SEscalationPolicyCreateCommand struct {
+	tenantId uuid.UUID
+	name     string
+	steps    []valueObjects.EscalationStep
+}
+
+func NewEscalationPolicyCreateCommand(tenantId uuid.UUID, name string, steps []valueObjects.EscalationStep) SEscalationPolicyCreateCommand {
+	return SEscalationPolicyCreateCommand{
+		tenantId: tenantId,
+		name:     name,
+		steps:    steps,
+	}
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SEscalationPolicyCreateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newEscalationPolicyCreateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SEscalationPolicyCreateCommandHandler {
+	return SEscalationPolicyCreateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SEscalationPolicyCreateCommandHandler) Handle(ctx *contextplus.Context, command SEscalationPolicyCreateCommand) (*entities.EscalationPolicy, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	escalationPolicy := entities.NewEscalationPolicy(command.tenantId, command.name, command.steps)
+	if err := r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		notificationChannelIds := make([]uint, 0, len(command.steps))
+		for _, step := range command.steps {
+			notificationChannelIds = append(notificationChannelIds, step.NotificationChannelId)
+		}
+
+		notificationChannels, err := iUnitOfWork.NotificationChannelRepository().All(ctx, genericRepository.In("id", notificationChannelIds...), genericRepository.Equal("tenant_id", command.tenantId))
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find escalation notification channels")
+
+			return common.ErrorInternalServer
+		}
+
+		found := make(map[uint]bool, len(notificationChannels))
+		for _, notificationChannel := range notificationChannels {
+			found[notificationChannel.Id] = true
+		}
+		for _, notificationChannelId := range notificationChannelIds {
+			if !found[notificationChannelId] {
+				return common.ErrorBadRequest
+			}
+		}
+
+		if err = iUnitOfWork.EscalationPolicyRepository().Create(ctx, &escalationPolicy); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).WithAny("escalationPolicy", escalationPolicy).Error(ctx, "error in create new escalation policy")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionCreate, "escalationPolicy", escalationPolicy.Id, nil, escalationPolicy); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &escalationPolicy, nil
+}
@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SEscalationPolicyDeleteCommand struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewEscalationPolicyDeleteCommand(tenantId uuid.UUID, id uint) SEscalationPolicyDeleteCommand {
+	return SEscalationPolicyDeleteCommand{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
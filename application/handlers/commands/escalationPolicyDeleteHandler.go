@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SEscalationPolicyDeleteCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newEscalationPolicyDeleteCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SEscalationPolicyDeleteCommandHandler {
+	return SEscalationPolicyDeleteCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SEscalationPolicyDeleteCommandHandler) Handle(ctx *contextplus.Context, command SEscalationPolicyDeleteCommand) (escalationPolicy *entities.EscalationPolicy, err error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if escalationPolicy, err = iUnitOfWork.EscalationPolicyRepository().SingleOrDefault(
+			ctx,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in find escalation policy")
+
+			return common.ErrorInternalServer
+		}
+
+		if escalationPolicy == nil {
+			return common.ErrorNotFound
+		}
+
+		before := *escalationPolicy
+
+		var inUse bool
+		if inUse, err = iUnitOfWork.HealthCheckRepository().Exists(ctx, genericRepository.Equal("escalation_policy_id", command.id), genericRepository.Equal("tenant_id", command.tenantId)); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in find escalation policy health checks")
+
+			return common.ErrorInternalServer
+		}
+
+		if inUse {
+			return common.ErrorBadRequest
+		}
+
+		if escalationPolicy, err = iUnitOfWork.EscalationPolicyRepository().Delete(
+			ctx,
+			escalationPolicy,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in delete escalation policy")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionDelete, "escalationPolicy", before.Id, before, nil); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return escalationPolicy, nil
+}
@@ -1,21 +1,74 @@
 package commands
 
-import "health-check/domain/enums"
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+)
 
 type SHealthCheckCreateCommand struct {
-	interval string
-	url      string
-	method   enums.HttpMethod
-	headers  map[string]string
-	body     map[string]any
+	tenantId           uuid.UUID
+	idempotencyKey     string
+	name               string
+	description        string
+	runbookUrl         string
+	owner              string
+	interval           string
+	url                string
+	method             enums.HttpMethod
+	headers            map[string]string
+	body               map[string]any
+	probeType          enums.ProbeType
+	settings           valueObjects.ProbeSettings
+	escalationPolicyId *uint
+	tags               []string
+	labels             map[string]string
 }
 
-func NewHealthCheckCreateCommand(interval string, url string, method enums.HttpMethod, headers map[string]string, body map[string]any) SHealthCheckCreateCommand {
+func NewHealthCheckCreateCommand(tenantId uuid.UUID, idempotencyKey string, name string, description string, runbookUrl string, owner string, interval string, url string, method enums.HttpMethod, headers map[string]string, body map[string]any, probeType enums.ProbeType, settings valueObjects.ProbeSettings, escalationPolicyId *uint, tags []string, labels map[string]string) SHealthCheckCreateCommand {
 	return SHealthCheckCreateCommand{
-		interval: interval,
-		url:      url,
-		method:   method,
-		headers:  headers,
-		body:     body,
+		tenantId:           tenantId,
+		idempotencyKey:     idempotencyKey,
+		name:               name,
+		description:        description,
+		runbookUrl:         runbookUrl,
+		owner:              owner,
+		interval:           interval,
+		url:                url,
+		method:             method,
+		headers:            headers,
+		body:               body,
+		probeType:          probeTypeOrDefault(probeType),
+		settings:           settings,
+		escalationPolicyId: escalationPolicyId,
+		tags:               tags,
+		labels:             labels,
 	}
 }
+
+func (r SHealthCheckCreateCommand) request() map[string]any {
+	return map[string]any{
+		"name":               r.name,
+		"description":        r.description,
+		"runbookUrl":         r.runbookUrl,
+		"owner":              r.owner,
+		"interval":           r.interval,
+		"url":                r.url,
+		"method":             r.method,
+		"headers":            r.headers,
+		"body":               r.body,
+		"type":               r.probeType,
+		"settings":           r.settings,
+		"escalationPolicyId": r.escalationPolicyId,
+		"tags":               r.tags,
+		"labels":             r.labels,
+	}
+}
+
+// probeTypeOrDefault keeps clients written before probe types existed creating http checks.
+func probeTypeOrDefault(probeType enums.ProbeType) enums.ProbeType {
+	if probeType == "" {
+		return enums.ProbeTypeHttp
+	}
+	return probeType
+}
@@ -8,27 +8,35 @@ import (
 	"health-check/application/interfaces"
 	"health-check/domain/entities"
 	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
 	"health-check/pkg/tracer"
+	"time"
 )
 
 type SHealthCheckCreateCommandHandler struct {
-	iLogger     logger.ILogger
-	iTracer     tracer.ITracer
-	iRedis      interfaces.IRedis
-	iUnitOfWork interfaces.IUnitOfWork
+	iLogger        logger.ILogger
+	iTracer        tracer.ITracer
+	iRedis         interfaces.IRedis
+	iCipher        interfaces.ICipher
+	iUnitOfWork    interfaces.IUnitOfWork
+	idempotencyTtl time.Duration
 }
 
 func newHealthCheckCreateCommandHandler(
 	iLogger logger.ILogger,
 	iTracer tracer.ITracer,
 	iRedis interfaces.IRedis,
+	iCipher interfaces.ICipher,
 	iUnitOfWork interfaces.IUnitOfWork,
+	idempotencyTtl time.Duration,
 ) SHealthCheckCreateCommandHandler {
 	return SHealthCheckCreateCommandHandler{
-		iLogger:     iLogger,
-		iTracer:     iTracer,
-		iRedis:      iRedis,
-		iUnitOfWork: iUnitOfWork,
+		iLogger:        iLogger,
+		iTracer:        iTracer,
+		iRedis:         iRedis,
+		iCipher:        iCipher,
+		iUnitOfWork:    iUnitOfWork,
+		idempotencyTtl: idempotencyTtl,
 	}
 }
 
@@ -36,22 +44,88 @@ func (r SHealthCheckCreateCommandHandler) Handle(ctx *contextplus.Context, comma
 	span, ctx := r.iTracer.SpanFromContext(ctx)
 	defer span.Finish()
 
-	healthCheck := entities.NewHealthCheck(command.interval, command.url, command.method, command.headers, command.body, enums.StatusStart)
+	return idempotent(ctx, r.iLogger, r.iRedis, r.idempotencyTtl, "healthCheckCreate", command.tenantId, command.idempotencyKey, command.request(), (*entities.HealthCheck).Redacted, func() (*entities.HealthCheck, error) {
+		return r.create(ctx, command)
+	})
+}
+
+func (r SHealthCheckCreateCommandHandler) create(ctx *contextplus.Context, command SHealthCheckCreateCommand) (*entities.HealthCheck, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if !command.settings.IsValid(command.probeType, command.url, command.method) {
+		return nil, common.ErrorBadRequest
+	}
+
+	settings, err := command.settings.Seal(command.tenantId, r.iCipher.Encrypt)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in seal probe credentials")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	healthCheck := entities.NewHealthCheck(command.tenantId, command.name, command.description, command.runbookUrl, command.owner, command.interval, command.url, command.method, command.headers, command.body, command.probeType, settings, enums.StatusStart, command.escalationPolicyId)
 	if err := r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
-		if err := iUnitOfWork.HealthCheckRepository().Create(ctx, &healthCheck); err != nil {
+		exists, err := iUnitOfWork.HealthCheckRepository().Exists(
+			ctx,
+			genericRepository.Equal("tenant_id", command.tenantId),
+			genericRepository.Equal("owner", command.owner),
+			genericRepository.Equal("name", command.name),
+		)
+		if err != nil {
 			span.SetTag("error", true)
 			span.LogKV("err", err)
-			r.iLogger.WithError(err).WithAny("command", command).WithAny("healthCheck", healthCheck).Error(ctx, "error in create new health check")
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find health check")
 
 			return common.ErrorInternalServer
 		}
 
-		payload, err := json.Marshal(healthCheck)
+		if exists {
+			return common.ErrorBadRequest
+		}
+
+		if command.escalationPolicyId != nil {
+			exists, err = iUnitOfWork.EscalationPolicyRepository().Exists(ctx, genericRepository.Equal("id", *command.escalationPolicyId), genericRepository.Equal("tenant_id", command.tenantId))
+			if err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find escalation policy")
+
+				return common.ErrorInternalServer
+			}
+
+			if !exists {
+				return common.ErrorBadRequest
+			}
+		}
+
+		var tags []entities.Tag
+		tags, err = findOrCreateTags(ctx, iUnitOfWork, command.tenantId, command.tags)
 		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find or create tags")
+
 			return common.ErrorInternalServer
 		}
+		healthCheck.SetTags(tags)
+		healthCheck.SetLabels(command.labels)
+
+		if err = iUnitOfWork.HealthCheckRepository().Create(ctx, &healthCheck); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).WithAny("healthCheck", healthCheck).Error(ctx, "error in create new health check")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionCreate, "healthCheck", healthCheck.Id, nil, healthCheck); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
 
-		if err = r.iRedis.Publish(ctx, "healthCheck", payload); err != nil {
 			return common.ErrorInternalServer
 		}
 
@@ -60,5 +134,16 @@ func (r SHealthCheckCreateCommandHandler) Handle(ctx *contextplus.Context, comma
 		return nil, err
 	}
 
+	// the job hears of the health check only once it is committed, like the import does
+	payload, err := json.Marshal(healthCheck)
+	if err == nil {
+		err = r.iRedis.Publish(ctx, "healthCheck", payload)
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("id", healthCheck.Id).Error(ctx, "error in publish created health check")
+	}
+
 	return &healthCheck, nil
 }
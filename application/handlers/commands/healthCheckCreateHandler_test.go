@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/genericRepository"
+	"testing"
+	"time"
+)
+
+func TestHealthCheckCreateHandle(t *testing.T) {
+	tenantId := uuid.New()
+	newCommand := func(name string, owner string) SHealthCheckCreateCommand {
+		return NewHealthCheckCreateCommand(tenantId, "", name, "public api", "https://wiki.internal/api", owner, "@every 1m", "https://api.internal", enums.HttpMethodGET, nil, nil, "", valueObjects.ProbeSettings{}, nil, nil, nil)
+	}
+
+	tableTests := []struct {
+		name       string
+		command    SHealthCheckCreateCommand
+		exists     bool
+		publishErr error
+		err        error
+	}{
+		{
+			name:    "name already used by the owner",
+			command: newCommand("api", "platform"),
+			exists:  true,
+			err:     common.ErrorBadRequest,
+		},
+		{
+			name:    "named health check",
+			command: newCommand("api", "platform"),
+		},
+		{
+			name:       "publish fails after the commit",
+			command:    newCommand("api", "platform"),
+			publishErr: errors.New("redis is down"),
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckCreateCommandHandler := newHealthCheckCreateCommandHandler(mock.iLogger, mock.iTracer, mock.iRedis, mock.iCipher, mock.iUnitOfWork, time.Hour)
+			ctx := contextplus.Background()
+
+			mock.expectSpan(ctx, 2)
+			mock.expectDo(ctx)
+			mock.iUnitOfWork.EXPECT().HealthCheckRepository().Return(mock.iHealthCheckRepository).MinTimes(1)
+			mock.iHealthCheckRepository.EXPECT().Exists(
+				ctx,
+				genericRepository.Equal("tenant_id", tenantId),
+				genericRepository.Equal("owner", tableTest.command.owner),
+				genericRepository.Equal("name", tableTest.command.name),
+			).Return(tableTest.exists, nil).Times(1)
+			if tableTest.err == nil {
+				mock.iHealthCheckRepository.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+				mock.expectAudit(ctx, nil)
+				mock.iRedis.EXPECT().Publish(ctx, "healthCheck", gomock.Any()).Return(tableTest.publishErr).Times(1)
+			}
+			if tableTest.publishErr != nil {
+				mock.iSpan.EXPECT().SetTag("error", true).Times(1)
+				mock.iSpan.EXPECT().LogKV("err", tableTest.publishErr).Times(1)
+				mock.iLogger.EXPECT().WithError(tableTest.publishErr).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithUint("id", uint(0)).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().Error(ctx, "error in publish created health check").Times(1)
+			}
+
+			healthCheck, err := healthCheckCreateCommandHandler.Handle(ctx, tableTest.command)
+
+			assert.Equal(t, tableTest.err, err)
+			if tableTest.err != nil {
+				return
+			}
+			assert.Equal(t, tenantId, healthCheck.TenantId)
+			assert.Equal(t, "api", healthCheck.Name)
+			assert.Equal(t, "platform", healthCheck.Owner)
+			assert.Equal(t, "public api", healthCheck.Description)
+			assert.Equal(t, "https://wiki.internal/api", healthCheck.RunbookUrl)
+			assert.Equal(t, enums.ProbeTypeHttp, healthCheck.Type)
+		})
+	}
+}
@@ -1,11 +1,17 @@
 package commands
 
+import "github.com/google/uuid"
+
 type SHealthCheckDeleteCommand struct {
-	id uint
+	tenantId uuid.UUID
+	id       uint
+	version  *uint
 }
 
-func NewHealthCheckDeleteCommand(id uint) SHealthCheckDeleteCommand {
+func NewHealthCheckDeleteCommand(tenantId uuid.UUID, id uint, version *uint) SHealthCheckDeleteCommand {
 	return SHealthCheckDeleteCommand{
-		id: id,
+		tenantId: tenantId,
+		id:       id,
+		version:  version,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"health-check/application/common"
 	"health-check/application/interfaces"
 	"health-check/domain/entities"
+	"health-check/domain/enums"
 	"health-check/pkg/genericRepository"
 	"health-check/pkg/tracer"
 )
@@ -40,6 +41,7 @@ func (r SHealthCheckDeleteCommandHandler) Handle(ctx *contextplus.Context, comma
 		if healthCheck, err = iUnitOfWork.HealthCheckRepository().SingleOrDefault(
 			ctx,
 			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
 		); err != nil {
 			span.SetTag("error", true)
 			span.LogKV("err", err)
@@ -52,10 +54,34 @@ func (r SHealthCheckDeleteCommandHandler) Handle(ctx *contextplus.Context, comma
 			return common.ErrorNotFound
 		}
 
+		if healthCheck.Managed {
+			return common.ErrorForbidden
+		}
+
+		if command.version != nil && *command.version != healthCheck.Version {
+			return common.ErrorConflict
+		}
+
+		before := *healthCheck
+
+		var incremented bool
+		if incremented, err = iUnitOfWork.HealthCheckRepository().IncrementVersion(ctx, healthCheck); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in increment health check version")
+
+			return common.ErrorInternalServer
+		}
+
+		if !incremented {
+			return common.ErrorConflict
+		}
+
 		if healthCheck, err = iUnitOfWork.HealthCheckRepository().Delete(
 			ctx,
 			healthCheck,
 			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
 		); err != nil {
 			span.SetTag("error", true)
 			span.LogKV("err", err)
@@ -64,6 +90,14 @@ func (r SHealthCheckDeleteCommandHandler) Handle(ctx *contextplus.Context, comma
 			return common.ErrorInternalServer
 		}
 
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionDelete, "healthCheck", before.Id, before, nil); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
 		var payload []byte
 		if payload, err = json.Marshal(healthCheck); err != nil {
 			return common.ErrorInternalServer
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"testing"
+)
+
+func TestHealthCheckDeleteHandle(t *testing.T) {
+	tenantId := uuid.New()
+	userId := uuid.New()
+
+	tableTests := []struct {
+		name        string
+		healthCheck *entities.HealthCheck
+		version     *uint
+		stale       bool
+		incremented bool
+		err         error
+	}{
+		{
+			name: "health check of another tenant",
+			err:  common.ErrorNotFound,
+		},
+		{
+			name:        "managed health check",
+			healthCheck: &entities.HealthCheck{Id: 1, TenantId: tenantId, Version: 1, Managed: true},
+			err:         common.ErrorForbidden,
+		},
+		{
+			name:        "stale version",
+			healthCheck: &entities.HealthCheck{Id: 1, TenantId: tenantId, Version: 2},
+			version:     version(1),
+			stale:       true,
+			err:         common.ErrorConflict,
+		},
+		{
+			name:        "concurrent write",
+			healthCheck: &entities.HealthCheck{Id: 1, TenantId: tenantId, Version: 1},
+			version:     version(1),
+			err:         common.ErrorConflict,
+		},
+		{
+			name:        "health check of the tenant",
+			healthCheck: &entities.HealthCheck{Id: 1, TenantId: tenantId, Version: 1},
+			incremented: true,
+		},
+		{
+			name:        "matching version",
+			healthCheck: &entities.HealthCheck{Id: 1, TenantId: tenantId, Version: 1},
+			version:     version(1),
+			incremented: true,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckDeleteCommandHandler := newHealthCheckDeleteCommandHandler(mock.iLogger, mock.iTracer, mock.iRedis, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+			ctx.User.SetId(userId)
+
+			mock.expectSpan(ctx, 1)
+			mock.expectDo(ctx)
+			mock.iUnitOfWork.EXPECT().HealthCheckRepository().Return(mock.iHealthCheckRepository).MinTimes(1)
+			mock.iHealthCheckRepository.EXPECT().SingleOrDefault(
+				ctx,
+				genericRepository.Equal("id", uint(1)),
+				genericRepository.Equal("tenant_id", tenantId),
+			).Return(tableTest.healthCheck, nil).Times(1)
+			if tableTest.err == nil || (tableTest.err == common.ErrorConflict && !tableTest.stale) {
+				mock.iHealthCheckRepository.EXPECT().IncrementVersion(ctx, tableTest.healthCheck).Return(tableTest.incremented, nil).Times(1)
+			}
+			if tableTest.err == nil {
+				mock.iHealthCheckRepository.EXPECT().Delete(
+					ctx,
+					tableTest.healthCheck,
+					genericRepository.Equal("id", uint(1)),
+					genericRepository.Equal("tenant_id", tenantId),
+				).Return(tableTest.healthCheck, nil).Times(1)
+				mock.expectAudit(ctx, func(auditLog *entities.AuditLog) {
+					assert.Equal(t, tenantId, auditLog.TenantId)
+					assert.Equal(t, userId, auditLog.ActorId)
+					assert.Equal(t, enums.AuditActionDelete, auditLog.Action)
+				})
+				mock.iRedis.EXPECT().Publish(ctx, "healthCheck", gomock.Any()).Return(nil).Times(1)
+			}
+
+			healthCheck, err := healthCheckDeleteCommandHandler.Handle(ctx, NewHealthCheckDeleteCommand(tenantId, 1, tableTest.version))
+
+			assert.Equal(t, tableTest.err, err)
+			if tableTest.err != nil {
+				return
+			}
+			assert.Equal(t, tenantId, healthCheck.TenantId)
+		})
+	}
+}
+
+func version(version uint) *uint {
+	return &version
+}
@@ -0,0 +1,17 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SHealthCheckDependencyCreateCommand struct {
+	tenantId      uuid.UUID
+	healthCheckId uint
+	parentId      uint
+}
+
+func NewHealthCheckDependencyCreateCommand(tenantId uuid.UUID, healthCheckId uint, parentId uint) SHealthCheckDependencyCreateCommand {
+	return SHealthCheckDependencyCreateCommand{
+		tenantId:      tenantId,
+		healthCheckId: healthCheckId,
+		parentId:      parentId,
+	}
+}
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SHealthCheckDependencyCreateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newHealthCheckDependencyCreateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SHealthCheckDependencyCreateCommandHandler {
+	return SHealthCheckDependencyCreateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SHealthCheckDependencyCreateCommandHandler) Handle(ctx *contextplus.Context, command SHealthCheckDependencyCreateCommand) (*entities.HealthCheckDependency, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if command.healthCheckId == command.parentId {
+		return nil, common.ErrorBadRequest
+	}
+
+	healthCheckDependency := entities.NewHealthCheckDependency(command.tenantId, command.healthCheckId, command.parentId)
+	if err := r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		// the cycle check reads the whole graph, concurrent creates of the tenant wait here until this one commits
+		if err := iUnitOfWork.HealthCheckDependencyRepository().LockTenant(ctx, command.tenantId); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in lock health check dependencies")
+
+			return common.ErrorInternalServer
+		}
+
+		count, err := iUnitOfWork.HealthCheckRepository().Count(ctx, genericRepository.In("id", command.healthCheckId, command.parentId), genericRepository.Equal("tenant_id", command.tenantId))
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find health checks")
+
+			return common.ErrorInternalServer
+		}
+
+		if count != 2 {
+			return common.ErrorBadRequest
+		}
+
+		healthCheckDependencies, err := iUnitOfWork.HealthCheckDependencyRepository().All(ctx, genericRepository.Equal("tenant_id", command.tenantId))
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in get all health check dependencies")
+
+			return common.ErrorInternalServer
+		}
+
+		for _, dependency := range healthCheckDependencies {
+			if dependency.HealthCheckId == command.healthCheckId && dependency.ParentId == command.parentId {
+				return common.ErrorBadRequest
+			}
+		}
+
+		if entities.CreatesDependencyCycle(healthCheckDependencies, command.healthCheckId, command.parentId) {
+			return common.ErrorBadRequest
+		}
+
+		if err = iUnitOfWork.HealthCheckDependencyRepository().Create(ctx, &healthCheckDependency); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).WithAny("healthCheckDependency", healthCheckDependency).Error(ctx, "error in create new health check dependency")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionCreate, "healthCheckDependency", healthCheckDependency.Id, nil, healthCheckDependency); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &healthCheckDependency, nil
+}
@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/domain/entities"
+	"testing"
+)
+
+func TestHealthCheckDependencyCreateHandle(t *testing.T) {
+	tenantId := uuid.New()
+	chain := []entities.HealthCheckDependency{
+		entities.NewHealthCheckDependency(tenantId, 2, 1),
+		entities.NewHealthCheckDependency(tenantId, 3, 2),
+	}
+
+	tableTests := []struct {
+		name          string
+		healthCheckId uint
+		parentId      uint
+		count         int64
+		dependencies  []entities.HealthCheckDependency
+		err           error
+	}{
+		{
+			name:          "health check depending on itself",
+			healthCheckId: 1,
+			parentId:      1,
+			err:           common.ErrorBadRequest,
+		},
+		{
+			name:          "parent of another tenant",
+			healthCheckId: 4,
+			parentId:      1,
+			count:         1,
+			err:           common.ErrorBadRequest,
+		},
+		{
+			name:          "duplicated dependency",
+			healthCheckId: 3,
+			parentId:      2,
+			count:         2,
+			dependencies:  chain,
+			err:           common.ErrorBadRequest,
+		},
+		{
+			name:          "dependency closing a cycle",
+			healthCheckId: 1,
+			parentId:      3,
+			count:         2,
+			dependencies:  chain,
+			err:           common.ErrorBadRequest,
+		},
+		{
+			name:          "dependency extending the chain",
+			healthCheckId: 4,
+			parentId:      3,
+			count:         2,
+			dependencies:  chain,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckDependencyCreateCommandHandler := newHealthCheckDependencyCreateCommandHandler(mock.iLogger, mock.iTracer, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+
+			mock.expectSpan(ctx, 1)
+			if tableTest.count != 0 {
+				mock.expectDo(ctx)
+				mock.iUnitOfWork.EXPECT().HealthCheckDependencyRepository().Return(mock.iHealthCheckDependencyRepository).MinTimes(1)
+				mock.iHealthCheckDependencyRepository.EXPECT().LockTenant(ctx, tenantId).Return(nil).Times(1)
+				mock.iUnitOfWork.EXPECT().HealthCheckRepository().Return(mock.iHealthCheckRepository).Times(1)
+				mock.iHealthCheckRepository.EXPECT().Count(ctx, gomock.Any(), gomock.Any()).Return(tableTest.count, nil).Times(1)
+			}
+			if tableTest.count == 2 {
+				mock.iHealthCheckDependencyRepository.EXPECT().All(ctx, gomock.Any()).Return(tableTest.dependencies, nil).Times(1)
+			}
+			if tableTest.err == nil {
+				mock.iHealthCheckDependencyRepository.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+				mock.expectAudit(ctx, nil)
+			}
+
+			healthCheckDependency, err := healthCheckDependencyCreateCommandHandler.Handle(ctx, NewHealthCheckDependencyCreateCommand(tenantId, tableTest.healthCheckId, tableTest.parentId))
+
+			assert.Equal(t, tableTest.err, err)
+			if tableTest.err != nil {
+				return
+			}
+			assert.Equal(t, tableTest.healthCheckId, healthCheckDependency.HealthCheckId)
+			assert.Equal(t, tableTest.parentId, healthCheckDependency.ParentId)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SHealthCheckDependencyDeleteCommand struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewHealthCheckDependencyDeleteCommand(tenantId uuid.UUID, id uint) SHealthCheckDependencyDeleteCommand {
+	return SHealthCheckDependencyDeleteCommand{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SHealthCheckDependencyDeleteCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newHealthCheckDependencyDeleteCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SHealthCheckDependencyDeleteCommandHandler {
+	return SHealthCheckDependencyDeleteCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SHealthCheckDependencyDeleteCommandHandler) Handle(ctx *contextplus.Context, command SHealthCheckDependencyDeleteCommand) (healthCheckDependency *entities.HealthCheckDependency, err error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if healthCheckDependency, err = iUnitOfWork.HealthCheckDependencyRepository().SingleOrDefault(
+			ctx,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in find health check dependency")
+
+			return common.ErrorInternalServer
+		}
+
+		if healthCheckDependency == nil {
+			return common.ErrorNotFound
+		}
+
+		before := *healthCheckDependency
+
+		if healthCheckDependency, err = iUnitOfWork.HealthCheckDependencyRepository().Delete(
+			ctx,
+			healthCheckDependency,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in delete health check dependency")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionDelete, "healthCheckDependency", before.Id, before, nil); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return healthCheckDependency, nil
+}
@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+)
+
+type SHealthCheckImportCommand struct {
+	tenantId    uuid.UUID
+	dryRun      bool
+	managed     bool
+	pruneMode   enums.PruneMode
+	definitions []valueObjects.HealthCheckDefinition
+}
+
+// NewHealthCheckImportCommand imports definitions into the tenant, a managed import also prunes the managed health
+// checks missing from definitions the way pruneMode says.
+func NewHealthCheckImportCommand(tenantId uuid.UUID, dryRun bool, managed bool, pruneMode enums.PruneMode, definitions []valueObjects.HealthCheckDefinition) SHealthCheckImportCommand {
+	return SHealthCheckImportCommand{
+		tenantId:    tenantId,
+		dryRun:      dryRun,
+		managed:     managed,
+		pruneMode:   pruneMode,
+		definitions: definitions,
+	}
+}
@@ -0,0 +1,398 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/google/uuid"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"slices"
+)
+
+type SHealthCheckImportCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iRedis      interfaces.IRedis
+	iCipher     interfaces.ICipher
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newHealthCheckImportCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iRedis interfaces.IRedis,
+	iCipher interfaces.ICipher,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SHealthCheckImportCommandHandler {
+	return SHealthCheckImportCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iRedis:      iRedis,
+		iCipher:     iCipher,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SHealthCheckImportCommandHandler) Handle(ctx *contextplus.Context, command SHealthCheckImportCommand) (changes []valueObjects.HealthCheckImportChange, err error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	var affected []entities.HealthCheck
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		keys := make(map[[2]string]bool, len(command.definitions))
+		for _, definition := range command.definitions {
+			key := [2]string{definition.Owner, definition.Name}
+			if keys[key] {
+				return common.ErrorBadRequest
+			}
+			keys[key] = true
+		}
+
+		var escalationPolicies []entities.EscalationPolicy
+		if escalationPolicies, err = iUnitOfWork.EscalationPolicyRepository().All(ctx, genericRepository.Equal("tenant_id", command.tenantId)); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find escalation policies")
+
+			return common.ErrorInternalServer
+		}
+
+		escalationPolicyIds := make(map[string]uint, len(escalationPolicies))
+		escalationPolicyNames := make(map[uint]string, len(escalationPolicies))
+		for _, escalationPolicy := range escalationPolicies {
+			escalationPolicyIds[escalationPolicy.Name] = escalationPolicy.Id
+			escalationPolicyNames[escalationPolicy.Id] = escalationPolicy.Name
+		}
+
+		var healthChecks []entities.HealthCheck
+		if healthChecks, err = iUnitOfWork.HealthCheckRepository().AllWithRelations(ctx, genericRepository.Equal("tenant_id", command.tenantId)); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find health checks")
+
+			return common.ErrorInternalServer
+		}
+
+		existing := make(map[[2]string]*entities.HealthCheck, len(healthChecks))
+		for i := range healthChecks {
+			existing[[2]string{healthChecks[i].Owner, healthChecks[i].Name}] = &healthChecks[i]
+		}
+
+		for _, definition := range command.definitions {
+			if !definition.IsValid() {
+				return common.ErrorBadRequest
+			}
+
+			// credentials arrive either plaintext or sealed by an export of the tenant, they are opened, sealed again and
+			// compared opened, ciphertext of another tenant or field does not open and is sealed like any plaintext
+			if definition.Settings, err = definition.Settings.Open(command.tenantId, r.openExported); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).Error(ctx, "error in open probe credentials")
+
+				return common.ErrorInternalServer
+			}
+
+			var settings valueObjects.ProbeSettings
+			if settings, err = definition.Settings.Seal(command.tenantId, r.iCipher.Encrypt); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).Error(ctx, "error in seal probe credentials")
+
+				return common.ErrorInternalServer
+			}
+
+			var escalationPolicyId *uint
+			if definition.EscalationPolicy != "" {
+				id, ok := escalationPolicyIds[definition.EscalationPolicy]
+				if !ok {
+					return common.ErrorBadRequest
+				}
+				escalationPolicyId = &id
+			}
+
+			healthCheck, ok := existing[[2]string{definition.Owner, definition.Name}]
+			if !ok {
+				change := valueObjects.HealthCheckImportChange{Owner: definition.Owner, Name: definition.Name, Action: enums.ImportActionCreate}
+				if !command.dryRun {
+					created := entities.NewHealthCheck(command.tenantId, definition.Name, definition.Description, definition.RunbookUrl, definition.Owner, definition.Interval, definition.Url, definition.Method, definition.Headers, definition.Body, definition.ProbeType(), settings, enums.StatusStart, escalationPolicyId)
+					created.SetManaged(command.managed)
+					if err = r.create(ctx, iUnitOfWork, &created, definition); err != nil {
+						span.SetTag("error", true)
+						span.LogKV("err", err)
+						r.iLogger.WithError(err).WithAny("definition", definition).Error(ctx, "error in import new health check")
+
+						return common.ErrorInternalServer
+					}
+					change.Id = created.Id
+					affected = append(affected, created)
+				}
+				changes = append(changes, change)
+				continue
+			}
+
+			if healthCheck.Managed && !command.managed {
+				return common.ErrorForbidden
+			}
+
+			var currentEscalationPolicy string
+			if healthCheck.EscalationPolicyId != nil {
+				currentEscalationPolicy = escalationPolicyNames[*healthCheck.EscalationPolicyId]
+			}
+
+			current := healthCheck.Definition(currentEscalationPolicy)
+			if current.Settings, err = current.Settings.Open(healthCheck.TenantId, r.iCipher.Decrypt); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithUint("id", healthCheck.Id).Error(ctx, "error in open probe credentials")
+
+				return common.ErrorInternalServer
+			}
+
+			var equal bool
+			if equal, err = sameDefinition(current, definition); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithAny("definition", definition).Error(ctx, "error in compare health check definitions")
+
+				return common.ErrorInternalServer
+			}
+
+			// a managed health check is only ever stopped by a prune, so its definition coming back starts it again
+			isPruned := command.managed && healthCheck.Managed && healthCheck.Status == enums.StatusStop
+
+			if equal && healthCheck.Managed == command.managed && !isPruned {
+				changes = append(changes, valueObjects.HealthCheckImportChange{Id: healthCheck.Id, Owner: definition.Owner, Name: definition.Name, Action: enums.ImportActionUnchanged})
+				continue
+			}
+
+			changes = append(changes, valueObjects.HealthCheckImportChange{Id: healthCheck.Id, Owner: definition.Owner, Name: definition.Name, Action: enums.ImportActionUpdate})
+			if command.dryRun {
+				continue
+			}
+
+			before := *healthCheck
+
+			var incremented bool
+			if incremented, err = iUnitOfWork.HealthCheckRepository().IncrementVersion(ctx, healthCheck); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithUint("id", healthCheck.Id).Error(ctx, "error in increment health check version")
+
+				return common.ErrorInternalServer
+			}
+
+			if !incremented {
+				return common.ErrorConflict
+			}
+
+			definition.Settings = settings
+			healthCheck.SetManaged(command.managed)
+			if isPruned {
+				healthCheck.SetStatus(enums.StatusStart)
+			}
+			if err = r.update(ctx, iUnitOfWork, before, healthCheck, definition, escalationPolicyId); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithAny("definition", definition).Error(ctx, "error in import existing health check")
+
+				return common.ErrorInternalServer
+			}
+			affected = append(affected, *healthCheck)
+		}
+
+		if !command.managed {
+			return nil
+		}
+
+		for i := range healthChecks {
+			healthCheck := &healthChecks[i]
+			if !healthCheck.Managed || keys[[2]string{healthCheck.Owner, healthCheck.Name}] {
+				continue
+			}
+
+			if command.pruneMode == enums.PruneModeStop {
+				if healthCheck.Status == enums.StatusStop {
+					continue
+				}
+
+				changes = append(changes, valueObjects.HealthCheckImportChange{Id: healthCheck.Id, Owner: healthCheck.Owner, Name: healthCheck.Name, Action: enums.ImportActionStop})
+				if command.dryRun {
+					continue
+				}
+
+				before := *healthCheck
+
+				var incremented bool
+				if incremented, err = iUnitOfWork.HealthCheckRepository().IncrementVersion(ctx, healthCheck); err != nil {
+					span.SetTag("error", true)
+					span.LogKV("err", err)
+					r.iLogger.WithError(err).WithUint("id", healthCheck.Id).Error(ctx, "error in increment health check version")
+
+					return common.ErrorInternalServer
+				}
+
+				if !incremented {
+					return common.ErrorConflict
+				}
+
+				if err = r.stop(ctx, iUnitOfWork, before, healthCheck); err != nil {
+					span.SetTag("error", true)
+					span.LogKV("err", err)
+					r.iLogger.WithError(err).WithUint("id", healthCheck.Id).Error(ctx, "error in stop managed health check")
+
+					return common.ErrorInternalServer
+				}
+				affected = append(affected, *healthCheck)
+				continue
+			}
+
+			changes = append(changes, valueObjects.HealthCheckImportChange{Id: healthCheck.Id, Owner: healthCheck.Owner, Name: healthCheck.Name, Action: enums.ImportActionDelete})
+			if command.dryRun {
+				continue
+			}
+
+			if err = r.delete(ctx, iUnitOfWork, healthCheck); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithUint("id", healthCheck.Id).Error(ctx, "error in prune managed health check")
+
+				return common.ErrorInternalServer
+			}
+			affected = append(affected, *healthCheck)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, healthCheck := range affected {
+		payload, err := json.Marshal(healthCheck)
+		if err == nil {
+			err = r.iRedis.Publish(ctx, "healthCheck", payload)
+		}
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", healthCheck.Id).Error(ctx, "error in publish imported health check")
+		}
+	}
+
+	return changes, nil
+}
+
+func (r SHealthCheckImportCommandHandler) create(ctx *contextplus.Context, iUnitOfWork interfaces.IUnitOfWork, healthCheck *entities.HealthCheck, definition valueObjects.HealthCheckDefinition) error {
+	tags, err := findOrCreateTags(ctx, iUnitOfWork, healthCheck.TenantId, definition.Tags)
+	if err != nil {
+		return err
+	}
+	healthCheck.SetTags(tags)
+	healthCheck.SetLabels(definition.Labels)
+
+	if err = iUnitOfWork.HealthCheckRepository().Create(ctx, healthCheck); err != nil {
+		return err
+	}
+
+	return audit(ctx, iUnitOfWork, healthCheck.TenantId, enums.AuditActionCreate, "healthCheck", healthCheck.Id, nil, healthCheck)
+}
+
+func (r SHealthCheckImportCommandHandler) update(ctx *contextplus.Context, iUnitOfWork interfaces.IUnitOfWork, before entities.HealthCheck, healthCheck *entities.HealthCheck, definition valueObjects.HealthCheckDefinition, escalationPolicyId *uint) error {
+	tags, err := findOrCreateTags(ctx, iUnitOfWork, healthCheck.TenantId, definition.Tags)
+	if err != nil {
+		return err
+	}
+
+	if err = iUnitOfWork.HealthCheckRepository().ReplaceTags(ctx, healthCheck, tags); err != nil {
+		return err
+	}
+	healthCheck.SetTags(tags)
+
+	if _, err = iUnitOfWork.HealthCheckLabelRepository().Delete(
+		ctx,
+		new(entities.HealthCheckLabel),
+		genericRepository.Equal("health_check_id", healthCheck.Id),
+	); err != nil {
+		return err
+	}
+
+	healthCheck.SetLabels(definition.Labels)
+	if len(healthCheck.Labels) > 0 {
+		if healthCheck.Labels, err = iUnitOfWork.HealthCheckLabelRepository().Creates(ctx, healthCheck.Labels...); err != nil {
+			return err
+		}
+	}
+
+	healthCheck.Update(definition.Name, definition.Description, definition.RunbookUrl, definition.Owner, definition.Interval, definition.Url, definition.Method, definition.Headers, definition.Body, definition.ProbeType(), definition.Settings, escalationPolicyId)
+
+	if _, err = iUnitOfWork.HealthCheckRepository().Save(ctx, healthCheck); err != nil {
+		return err
+	}
+
+	return audit(ctx, iUnitOfWork, healthCheck.TenantId, enums.AuditActionUpdate, "healthCheck", healthCheck.Id, before, healthCheck)
+}
+
+func (r SHealthCheckImportCommandHandler) delete(ctx *contextplus.Context, iUnitOfWork interfaces.IUnitOfWork, healthCheck *entities.HealthCheck) error {
+	before := *healthCheck
+
+	if _, err := iUnitOfWork.HealthCheckRepository().Delete(
+		ctx,
+		healthCheck,
+		genericRepository.Equal("id", healthCheck.Id),
+		genericRepository.Equal("tenant_id", healthCheck.TenantId),
+	); err != nil {
+		return err
+	}
+
+	return audit(ctx, iUnitOfWork, healthCheck.TenantId, enums.AuditActionDelete, "healthCheck", healthCheck.Id, before, nil)
+}
+
+func (r SHealthCheckImportCommandHandler) stop(ctx *contextplus.Context, iUnitOfWork interfaces.IUnitOfWork, before entities.HealthCheck, healthCheck *entities.HealthCheck) error {
+	healthCheck.SetStatus(enums.StatusStop)
+	if _, err := iUnitOfWork.HealthCheckRepository().UpdateColumn(
+		ctx,
+		"status",
+		healthCheck.Status,
+		genericRepository.Equal("id", healthCheck.Id),
+		genericRepository.Equal("tenant_id", healthCheck.TenantId),
+	); err != nil {
+		return err
+	}
+
+	return audit(ctx, iUnitOfWork, healthCheck.TenantId, enums.AuditActionStatus, "healthCheck", healthCheck.Id, before, healthCheck)
+}
+
+// sameDefinition compares two definitions by their json form, so tag order and number types do not matter.
+func sameDefinition(current valueObjects.HealthCheckDefinition, definition valueObjects.HealthCheckDefinition) (bool, error) {
+	definition.Type = definition.ProbeType()
+	definition.Tags = slices.Clone(definition.Tags)
+	slices.Sort(definition.Tags)
+	definition.Tags = slices.Compact(definition.Tags)
+
+	currentJson, err := json.Marshal(current)
+	if err != nil {
+		return false, err
+	}
+
+	definitionJson, err := json.Marshal(definition)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(currentJson, definitionJson), nil
+}
+
+// openExported opens a credential sealed for the field of the tenant and returns any other value as the plaintext it is.
+func (r SHealthCheckImportCommandHandler) openExported(tenantId uuid.UUID, field string, value string) (string, error) {
+	if opened, err := r.iCipher.Decrypt(tenantId, field, value); err == nil {
+		return opened, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,205 @@
+package commands
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/genericRepository"
+	"slices"
+	"testing"
+)
+
+func TestHealthCheckImportHandlePrune(t *testing.T) {
+	tenantId := uuid.New()
+	healthCheck := func(id uint, name string, managed bool) entities.HealthCheck {
+		healthCheck := entities.NewHealthCheck(tenantId, name, "", "", "platform", "@every 1m", "https://"+name+".example.com", enums.HttpMethodGET, nil, nil, enums.ProbeTypeHttp, valueObjects.ProbeSettings{}, enums.StatusStart, nil)
+		healthCheck.Id = id
+		healthCheck.SetManaged(managed)
+		return healthCheck
+	}
+
+	tableTests := []struct {
+		name      string
+		dryRun    bool
+		managed   bool
+		pruneMode enums.PruneMode
+		stopped   []uint
+		changes   []valueObjects.HealthCheckImportChange
+		expect    func(t *testing.T, mock *sMockCommandHandler, ctx *contextplus.Context)
+	}{
+		{
+			name: "unmanaged import does not prune",
+			changes: []valueObjects.HealthCheckImportChange{
+				{Id: 1, Owner: "platform", Name: "kept", Action: enums.ImportActionUnchanged},
+			},
+		},
+		{
+			name:      "dry run plans the prune",
+			dryRun:    true,
+			managed:   true,
+			pruneMode: enums.PruneModeDelete,
+			changes: []valueObjects.HealthCheckImportChange{
+				{Id: 1, Owner: "platform", Name: "kept", Action: enums.ImportActionUnchanged},
+				{Id: 2, Owner: "platform", Name: "removed", Action: enums.ImportActionDelete},
+			},
+		},
+		{
+			name:      "managed import prunes managed health checks only",
+			managed:   true,
+			pruneMode: enums.PruneModeDelete,
+			changes: []valueObjects.HealthCheckImportChange{
+				{Id: 1, Owner: "platform", Name: "kept", Action: enums.ImportActionUnchanged},
+				{Id: 2, Owner: "platform", Name: "removed", Action: enums.ImportActionDelete},
+			},
+			expect: func(t *testing.T, mock *sMockCommandHandler, ctx *contextplus.Context) {
+				mock.iHealthCheckRepository.EXPECT().Delete(
+					ctx,
+					gomock.Any(),
+					genericRepository.Equal("id", uint(2)),
+					genericRepository.Equal("tenant_id", tenantId),
+				).Return(nil, nil).Times(1)
+				mock.expectAudit(ctx, func(auditLog *entities.AuditLog) {
+					assert.Equal(t, enums.AuditActionDelete, auditLog.Action)
+					assert.Equal(t, uint(2), auditLog.EntityId)
+					assert.Nil(t, auditLog.After)
+				})
+				mock.iRedis.EXPECT().Publish(ctx, "healthCheck", gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:      "stop mode stops the pruned managed health checks",
+			managed:   true,
+			pruneMode: enums.PruneModeStop,
+			changes: []valueObjects.HealthCheckImportChange{
+				{Id: 1, Owner: "platform", Name: "kept", Action: enums.ImportActionUnchanged},
+				{Id: 2, Owner: "platform", Name: "removed", Action: enums.ImportActionStop},
+			},
+			expect: func(t *testing.T, mock *sMockCommandHandler, ctx *contextplus.Context) {
+				mock.iHealthCheckRepository.EXPECT().IncrementVersion(ctx, gomock.Any()).Return(true, nil).Times(1)
+				mock.iHealthCheckRepository.EXPECT().UpdateColumn(
+					ctx,
+					"status",
+					enums.StatusStop,
+					genericRepository.Equal("id", uint(2)),
+					genericRepository.Equal("tenant_id", tenantId),
+				).Return(nil, nil).Times(1)
+				mock.expectAudit(ctx, func(auditLog *entities.AuditLog) {
+					assert.Equal(t, enums.AuditActionStatus, auditLog.Action)
+					assert.Equal(t, uint(2), auditLog.EntityId)
+				})
+				mock.iRedis.EXPECT().Publish(ctx, "healthCheck", gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:      "stop mode leaves stopped managed health checks alone",
+			managed:   true,
+			pruneMode: enums.PruneModeStop,
+			stopped:   []uint{2},
+			changes: []valueObjects.HealthCheckImportChange{
+				{Id: 1, Owner: "platform", Name: "kept", Action: enums.ImportActionUnchanged},
+			},
+		},
+		{
+			name:      "definition coming back starts the stopped managed health check",
+			managed:   true,
+			pruneMode: enums.PruneModeStop,
+			stopped:   []uint{1, 2},
+			changes: []valueObjects.HealthCheckImportChange{
+				{Id: 1, Owner: "platform", Name: "kept", Action: enums.ImportActionUpdate},
+			},
+			expect: func(t *testing.T, mock *sMockCommandHandler, ctx *contextplus.Context) {
+				mock.iHealthCheckRepository.EXPECT().IncrementVersion(ctx, gomock.Any()).Return(true, nil).Times(1)
+				mock.iHealthCheckRepository.EXPECT().ReplaceTags(ctx, gomock.Any(), nil).Return(nil).Times(1)
+				mock.iUnitOfWork.EXPECT().HealthCheckLabelRepository().Return(mock.iHealthCheckLabelRepository).Times(1)
+				mock.iHealthCheckLabelRepository.EXPECT().Delete(ctx, gomock.Any(), genericRepository.Equal("health_check_id", uint(1))).Return(nil, nil).Times(1)
+				mock.iHealthCheckRepository.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, healthCheck *entities.HealthCheck) (*entities.HealthCheck, error) {
+					assert.Equal(t, enums.StatusStart, healthCheck.Status)
+					return healthCheck, nil
+				}).Times(1)
+				mock.expectAudit(ctx, func(auditLog *entities.AuditLog) {
+					assert.Equal(t, enums.AuditActionUpdate, auditLog.Action)
+				})
+				mock.iRedis.EXPECT().Publish(ctx, "healthCheck", gomock.Any()).Return(nil).Times(1)
+			},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckImportCommandHandler := newHealthCheckImportCommandHandler(mock.iLogger, mock.iTracer, mock.iRedis, mock.iCipher, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+			ctx.User.SetId(tenantId)
+			kept := healthCheck(1, "kept", tableTest.managed)
+			healthChecks := []entities.HealthCheck{kept, healthCheck(2, "removed", true), healthCheck(3, "manual", false)}
+			for i := range healthChecks {
+				if slices.Contains(tableTest.stopped, healthChecks[i].Id) {
+					healthChecks[i].SetStatus(enums.StatusStop)
+				}
+			}
+
+			mock.expectSpan(ctx, 1)
+			mock.expectDo(ctx)
+			mock.iUnitOfWork.EXPECT().EscalationPolicyRepository().Return(mock.iEscalationPolicyRepository).Times(1)
+			mock.iEscalationPolicyRepository.EXPECT().All(ctx, genericRepository.Equal("tenant_id", tenantId)).Return(nil, nil).Times(1)
+			mock.iUnitOfWork.EXPECT().HealthCheckRepository().Return(mock.iHealthCheckRepository).MinTimes(1)
+			mock.iHealthCheckRepository.EXPECT().AllWithRelations(ctx, genericRepository.Equal("tenant_id", tenantId)).Return(healthChecks, nil).Times(1)
+			if tableTest.expect != nil {
+				tableTest.expect(t, mock, ctx)
+			}
+
+			changes, err := healthCheckImportCommandHandler.Handle(ctx, NewHealthCheckImportCommand(tenantId, tableTest.dryRun, tableTest.managed, tableTest.pruneMode, []valueObjects.HealthCheckDefinition{kept.Definition("")}))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tableTest.changes, changes)
+		})
+	}
+}
+
+func TestHealthCheckImportOpenExported(t *testing.T) {
+	tenantId := uuid.New()
+
+	tableTests := []struct {
+		name   string
+		value  string
+		opened string
+		err    error
+	}{
+		{
+			name:   "sealed by an export of the tenant",
+			value:  "enc:exported",
+			opened: "s3cret",
+		},
+		{
+			name:   "sealed for another tenant",
+			value:  "enc:foreign",
+			opened: "enc:foreign",
+			err:    errors.New("cipher: message authentication failed"),
+		},
+		{
+			name:   "plaintext",
+			value:  "s3cret",
+			opened: "s3cret",
+			err:    errors.New("value is not sealed"),
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckImportCommandHandler := newHealthCheckImportCommandHandler(mock.iLogger, mock.iTracer, mock.iRedis, mock.iCipher, mock.iUnitOfWork)
+
+			mock.iCipher.EXPECT().Decrypt(tenantId, "settings.redis.password", tableTest.value).Return(tableTest.opened, tableTest.err).Times(1)
+
+			opened, err := healthCheckImportCommandHandler.openExported(tenantId, "settings.redis.password", tableTest.value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tableTest.opened, opened)
+		})
+	}
+}
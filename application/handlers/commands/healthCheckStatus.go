@@ -1,15 +1,22 @@
 package commands
 
-import "health-check/domain/enums"
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+)
 
 type SHealthCheckStatusCommand struct {
-	id     uint
-	status enums.Status
+	tenantId uuid.UUID
+	id       uint
+	version  *uint
+	status   enums.Status
 }
 
-func NewHealthCheckStatusCommand(id uint, status enums.Status) SHealthCheckStatusCommand {
+func NewHealthCheckStatusCommand(tenantId uuid.UUID, id uint, version *uint, status enums.Status) SHealthCheckStatusCommand {
 	return SHealthCheckStatusCommand{
-		id:     id,
-		status: status,
+		tenantId: tenantId,
+		id:       id,
+		version:  version,
+		status:   status,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"health-check/application/common"
 	"health-check/application/interfaces"
 	"health-check/domain/entities"
+	"health-check/domain/enums"
 	"health-check/pkg/genericRepository"
 	"health-check/pkg/tracer"
 )
@@ -40,6 +41,7 @@ func (r SHealthCheckStatusCommandHandler) Handle(ctx *contextplus.Context, comma
 		if healthCheck, err = iUnitOfWork.HealthCheckRepository().SingleOrDefault(
 			ctx,
 			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
 		); err != nil {
 			span.SetTag("error", true)
 			span.LogKV("err", err)
@@ -52,16 +54,40 @@ func (r SHealthCheckStatusCommandHandler) Handle(ctx *contextplus.Context, comma
 			return common.ErrorNotFound
 		}
 
+		if healthCheck.Managed {
+			return common.ErrorForbidden
+		}
+
+		if command.version != nil && *command.version != healthCheck.Version {
+			return common.ErrorConflict
+		}
+
+		before := *healthCheck
+
 		if healthCheck.Status == command.status {
 			return common.ErrorBadRequest
 		}
 
+		var incremented bool
+		if incremented, err = iUnitOfWork.HealthCheckRepository().IncrementVersion(ctx, healthCheck); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in increment health check version")
+
+			return common.ErrorInternalServer
+		}
+
+		if !incremented {
+			return common.ErrorConflict
+		}
+
 		healthCheck.SetStatus(command.status)
 
 		if healthCheck, err = iUnitOfWork.HealthCheckRepository().Update(
 			ctx,
 			healthCheck,
 			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
 		); err != nil {
 			span.SetTag("error", true)
 			span.LogKV("err", err)
@@ -70,6 +96,14 @@ func (r SHealthCheckStatusCommandHandler) Handle(ctx *contextplus.Context, comma
 			return common.ErrorInternalServer
 		}
 
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionStatus, "healthCheck", before.Id, before, healthCheck); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
 		var payload []byte
 		if payload, err = json.Marshal(healthCheck); err != nil {
 			return common.ErrorInternalServer
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"testing"
+)
+
+func TestHealthCheckStatusHandle(t *testing.T) {
+	tenantId := uuid.New()
+
+	tableTests := []struct {
+		name        string
+		version     *uint
+		stale       bool
+		incremented bool
+		err         error
+	}{
+		{
+			name:    "stale version",
+			version: version(1),
+			stale:   true,
+			err:     common.ErrorConflict,
+		},
+		{
+			name:    "concurrent write",
+			version: version(2),
+			err:     common.ErrorConflict,
+		},
+		{
+			name:        "without a version",
+			incremented: true,
+		},
+		{
+			name:        "matching version",
+			version:     version(2),
+			incremented: true,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckStatusCommandHandler := newHealthCheckStatusCommandHandler(mock.iLogger, mock.iTracer, mock.iRedis, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+			ctx.User.SetId(tenantId)
+			healthCheck := &entities.HealthCheck{Id: 1, TenantId: tenantId, Version: 2, Status: enums.StatusStart}
+
+			mock.expectSpan(ctx, 1)
+			mock.expectDo(ctx)
+			mock.iUnitOfWork.EXPECT().HealthCheckRepository().Return(mock.iHealthCheckRepository).MinTimes(1)
+			mock.iHealthCheckRepository.EXPECT().SingleOrDefault(
+				ctx,
+				genericRepository.Equal("id", uint(1)),
+				genericRepository.Equal("tenant_id", tenantId),
+			).Return(healthCheck, nil).Times(1)
+			if !tableTest.stale {
+				mock.iHealthCheckRepository.EXPECT().IncrementVersion(ctx, healthCheck).DoAndReturn(func(ctx *contextplus.Context, healthCheck *entities.HealthCheck) (bool, error) {
+					if tableTest.incremented {
+						healthCheck.Version++
+					}
+					return tableTest.incremented, nil
+				}).Times(1)
+			}
+			if tableTest.err == nil {
+				mock.iHealthCheckRepository.EXPECT().Update(
+					ctx,
+					healthCheck,
+					genericRepository.Equal("id", uint(1)),
+					genericRepository.Equal("tenant_id", tenantId),
+				).Return(healthCheck, nil).Times(1)
+				mock.expectAudit(ctx, func(auditLog *entities.AuditLog) {
+					assert.Equal(t, enums.AuditActionStatus, auditLog.Action)
+					assert.Contains(t, string(auditLog.Before), `"Version":2`)
+					assert.Contains(t, string(auditLog.After), `"Version":3`)
+				})
+				mock.iRedis.EXPECT().Publish(ctx, "healthCheck", gomock.Any()).Return(nil).Times(1)
+			}
+
+			result, err := healthCheckStatusCommandHandler.Handle(ctx, NewHealthCheckStatusCommand(tenantId, 1, tableTest.version, enums.StatusStop))
+
+			assert.Equal(t, tableTest.err, err)
+			if tableTest.err != nil {
+				assert.Nil(t, result)
+				return
+			}
+			assert.Equal(t, enums.StatusStop, result.Status)
+			assert.Equal(t, uint(3), result.Version)
+		})
+	}
+}
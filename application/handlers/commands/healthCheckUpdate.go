@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+)
+
+type SHealthCheckUpdateCommand struct {
+	tenantId           uuid.UUID
+	id                 uint
+	version            *uint
+	name               string
+	description        string
+	runbookUrl         string
+	owner              string
+	interval           string
+	url                string
+	method             enums.HttpMethod
+	headers            map[string]string
+	body               map[string]any
+	probeType          enums.ProbeType
+	settings           valueObjects.ProbeSettings
+	escalationPolicyId *uint
+	tags               []string
+	labels             map[string]string
+}
+
+func NewHealthCheckUpdateCommand(tenantId uuid.UUID, id uint, version *uint, name string, description string, runbookUrl string, owner string, interval string, url string, method enums.HttpMethod, headers map[string]string, body map[string]any, probeType enums.ProbeType, settings valueObjects.ProbeSettings, escalationPolicyId *uint, tags []string, labels map[string]string) SHealthCheckUpdateCommand {
+	return SHealthCheckUpdateCommand{
+		tenantId:           tenantId,
+		id:                 id,
+		version:            version,
+		name:               name,
+		description:        description,
+		runbookUrl:         runbookUrl,
+		owner:              owner,
+		interval:           interval,
+		url:                url,
+		method:             method,
+		headers:            headers,
+		body:               body,
+		probeType:          probeTypeOrDefault(probeType),
+		settings:           settings,
+		escalationPolicyId: escalationPolicyId,
+		tags:               tags,
+		labels:             labels,
+	}
+}
@@ -0,0 +1,209 @@
+package commands
+
+import (
+	"encoding/json"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SHealthCheckUpdateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iRedis      interfaces.IRedis
+	iCipher     interfaces.ICipher
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newHealthCheckUpdateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iRedis interfaces.IRedis,
+	iCipher interfaces.ICipher,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SHealthCheckUpdateCommandHandler {
+	return SHealthCheckUpdateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iRedis:      iRedis,
+		iCipher:     iCipher,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SHealthCheckUpdateCommandHandler) Handle(ctx *contextplus.Context, command SHealthCheckUpdateCommand) (healthCheck *entities.HealthCheck, err error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if !command.settings.IsValid(command.probeType, command.url, command.method) {
+		return nil, common.ErrorBadRequest
+	}
+
+	settings, err := command.settings.Seal(command.tenantId, r.iCipher.Encrypt)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in seal probe credentials")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if healthCheck, err = iUnitOfWork.HealthCheckRepository().SingleOrDefault(
+			ctx,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in find health check")
+
+			return common.ErrorInternalServer
+		}
+
+		if healthCheck == nil {
+			return common.ErrorNotFound
+		}
+
+		if healthCheck.Managed {
+			return common.ErrorForbidden
+		}
+
+		if command.version != nil && *command.version != healthCheck.Version {
+			return common.ErrorConflict
+		}
+
+		before := *healthCheck
+
+		var exists bool
+		if exists, err = iUnitOfWork.HealthCheckRepository().Exists(
+			ctx,
+			genericRepository.NotEqual("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+			genericRepository.Equal("owner", command.owner),
+			genericRepository.Equal("name", command.name),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find health check")
+
+			return common.ErrorInternalServer
+		}
+
+		if exists {
+			return common.ErrorBadRequest
+		}
+
+		if command.escalationPolicyId != nil {
+			if exists, err = iUnitOfWork.EscalationPolicyRepository().Exists(ctx, genericRepository.Equal("id", *command.escalationPolicyId), genericRepository.Equal("tenant_id", command.tenantId)); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find escalation policy")
+
+				return common.ErrorInternalServer
+			}
+
+			if !exists {
+				return common.ErrorBadRequest
+			}
+		}
+
+		var incremented bool
+		if incremented, err = iUnitOfWork.HealthCheckRepository().IncrementVersion(ctx, healthCheck); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in increment health check version")
+
+			return common.ErrorInternalServer
+		}
+
+		if !incremented {
+			return common.ErrorConflict
+		}
+
+		var tags []entities.Tag
+		if tags, err = findOrCreateTags(ctx, iUnitOfWork, command.tenantId, command.tags); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find or create tags")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = iUnitOfWork.HealthCheckRepository().ReplaceTags(ctx, healthCheck, tags); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in replace health check tags")
+
+			return common.ErrorInternalServer
+		}
+		healthCheck.SetTags(tags)
+
+		if _, err = iUnitOfWork.HealthCheckLabelRepository().Delete(
+			ctx,
+			new(entities.HealthCheckLabel),
+			genericRepository.Equal("health_check_id", command.id),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in delete health check labels")
+
+			return common.ErrorInternalServer
+		}
+
+		healthCheck.SetLabels(command.labels)
+		if len(healthCheck.Labels) > 0 {
+			if healthCheck.Labels, err = iUnitOfWork.HealthCheckLabelRepository().Creates(ctx, healthCheck.Labels...); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in create health check labels")
+
+				return common.ErrorInternalServer
+			}
+		}
+
+		// a client sends back the redacted headers and body it read, those keep the stored values
+		headers := valueObjects.RestoreHeaders(before.Headers.Data(), command.headers)
+		body := valueObjects.RestoreBody(before.Body.Data(), command.body)
+		healthCheck.Update(command.name, command.description, command.runbookUrl, command.owner, command.interval, command.url, command.method, headers, body, command.probeType, settings, command.escalationPolicyId)
+
+		if healthCheck, err = iUnitOfWork.HealthCheckRepository().Save(ctx, healthCheck); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in update health check")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionUpdate, "healthCheck", before.Id, before, healthCheck); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// the job hears of the health check only once it is committed, like the import does
+	payload, err := json.Marshal(healthCheck)
+	if err == nil {
+		err = r.iRedis.Publish(ctx, "healthCheck", payload)
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("id", healthCheck.Id).Error(ctx, "error in publish updated health check")
+	}
+
+	return healthCheck, nil
+}
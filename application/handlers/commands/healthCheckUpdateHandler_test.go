@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/genericRepository"
+	"testing"
+)
+
+func TestHealthCheckUpdateHandle(t *testing.T) {
+	tenantId := uuid.New()
+	stored := func(managed bool) *entities.HealthCheck {
+		healthCheck := entities.NewHealthCheck(tenantId, "api", "", "", "platform", "@every 1m", "https://api.internal", enums.HttpMethodGET, nil, nil, enums.ProbeTypeHttp, valueObjects.ProbeSettings{}, enums.StatusStart, nil)
+		healthCheck.Id = 1
+		healthCheck.Version = 2
+		healthCheck.SetManaged(managed)
+		return &healthCheck
+	}
+
+	tableTests := []struct {
+		name        string
+		healthCheck *entities.HealthCheck
+		version     *uint
+		exists      bool
+		incremented bool
+		err         error
+	}{
+		{
+			name: "health check of another tenant",
+			err:  common.ErrorNotFound,
+		},
+		{
+			name:        "managed health check",
+			healthCheck: stored(true),
+			err:         common.ErrorForbidden,
+		},
+		{
+			name:        "stale version",
+			healthCheck: stored(false),
+			version:     version(1),
+			err:         common.ErrorConflict,
+		},
+		{
+			name:        "name used by another health check of the owner",
+			healthCheck: stored(false),
+			exists:      true,
+			err:         common.ErrorBadRequest,
+		},
+		{
+			name:        "concurrent write",
+			healthCheck: stored(false),
+			version:     version(2),
+			err:         common.ErrorConflict,
+		},
+		{
+			name:        "tags of the tenant and labels are replaced",
+			healthCheck: stored(false),
+			version:     version(2),
+			incremented: true,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckUpdateCommandHandler := newHealthCheckUpdateCommandHandler(mock.iLogger, mock.iTracer, mock.iRedis, mock.iCipher, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+			paymentsTag := entities.Tag{Id: 1, TenantId: tenantId, Name: "payments"}
+
+			mock.expectSpan(ctx, 1)
+			mock.expectDo(ctx)
+			mock.iUnitOfWork.EXPECT().HealthCheckRepository().Return(mock.iHealthCheckRepository).MinTimes(1)
+			mock.iHealthCheckRepository.EXPECT().SingleOrDefault(
+				ctx,
+				genericRepository.Equal("id", uint(1)),
+				genericRepository.Equal("tenant_id", tenantId),
+			).Return(tableTest.healthCheck, nil).Times(1)
+			if tableTest.healthCheck != nil && !tableTest.healthCheck.Managed && (tableTest.version == nil || *tableTest.version == tableTest.healthCheck.Version) {
+				mock.iHealthCheckRepository.EXPECT().Exists(
+					ctx,
+					genericRepository.NotEqual("id", uint(1)),
+					genericRepository.Equal("tenant_id", tenantId),
+					genericRepository.Equal("owner", "platform"),
+					genericRepository.Equal("name", "api"),
+				).Return(tableTest.exists, nil).Times(1)
+				if !tableTest.exists {
+					mock.iHealthCheckRepository.EXPECT().IncrementVersion(ctx, tableTest.healthCheck).Return(tableTest.incremented, nil).Times(1)
+				}
+			}
+			if tableTest.err == nil {
+				mock.iUnitOfWork.EXPECT().TagRepository().Return(mock.iTagRepository).Times(2)
+				mock.iTagRepository.EXPECT().All(
+					ctx,
+					genericRepository.Equal("tenant_id", tenantId),
+					genericRepository.In("name", "payments", "checkout"),
+				).Return([]entities.Tag{paymentsTag}, nil).Times(1)
+				mock.iTagRepository.EXPECT().Creates(ctx, entities.NewTag(tenantId, "checkout")).DoAndReturn(func(ctx *contextplus.Context, tags ...entities.Tag) ([]entities.Tag, error) {
+					tags[0].Id = 2
+					return tags, nil
+				}).Times(1)
+				mock.iHealthCheckRepository.EXPECT().ReplaceTags(ctx, tableTest.healthCheck, []entities.Tag{paymentsTag, {Id: 2, TenantId: tenantId, Name: "checkout"}}).Return(nil).Times(1)
+				mock.iUnitOfWork.EXPECT().HealthCheckLabelRepository().Return(mock.iHealthCheckLabelRepository).Times(2)
+				mock.iHealthCheckLabelRepository.EXPECT().Delete(ctx, new(entities.HealthCheckLabel), genericRepository.Equal("health_check_id", uint(1))).Return(nil, nil).Times(1)
+				mock.iHealthCheckLabelRepository.EXPECT().Creates(ctx, entities.NewHealthCheckLabel(1, "team", "core")).DoAndReturn(func(ctx *contextplus.Context, labels ...entities.HealthCheckLabel) ([]entities.HealthCheckLabel, error) {
+					return labels, nil
+				}).Times(1)
+				mock.iHealthCheckRepository.EXPECT().Save(ctx, tableTest.healthCheck).Return(tableTest.healthCheck, nil).Times(1)
+				mock.expectAudit(ctx, func(auditLog *entities.AuditLog) {
+					assert.Equal(t, enums.AuditActionUpdate, auditLog.Action)
+					assert.Equal(t, uint(1), auditLog.EntityId)
+				})
+				mock.iRedis.EXPECT().Publish(ctx, "healthCheck", gomock.Any()).Return(nil).Times(1)
+			}
+
+			healthCheck, err := healthCheckUpdateCommandHandler.Handle(ctx, NewHealthCheckUpdateCommand(
+				tenantId, 1, tableTest.version, "api", "public api", "", "platform", "@every 1m", "https://api.internal", enums.HttpMethodGET, nil, nil, enums.ProbeTypeHttp, valueObjects.ProbeSettings{}, nil, []string{"payments", "checkout"}, map[string]string{"team": "core"},
+			))
+
+			assert.Equal(t, tableTest.err, err)
+			if tableTest.err != nil {
+				return
+			}
+			assert.Equal(t, "public api", healthCheck.Description)
+			assert.Equal(t, []string{"payments", "checkout"}, healthCheck.TagNames())
+			assert.Equal(t, map[string]string{"team": "core"}, healthCheck.LabelMap())
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package commands
+
+import "health-check/domain/enums"
+
+type SHeartbeatPingCommand struct {
+	token  string
+	signal enums.HeartbeatSignal
+	log    string
+}
+
+func NewHeartbeatPingCommand(token string, signal enums.HeartbeatSignal, log string) SHeartbeatPingCommand {
+	return SHeartbeatPingCommand{
+		token:  token,
+		signal: signal,
+		log:    log,
+	}
+}
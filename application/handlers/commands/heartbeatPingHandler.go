@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"time"
+)
+
+type SHeartbeatPingCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newHeartbeatPingCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SHeartbeatPingCommandHandler {
+	return SHeartbeatPingCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+// Handle stores the ping as a request of the heartbeat check, a success or fail following a start records how long
+// the run took. Going up or down is left to the heartbeat timer of the health check job.
+func (r SHeartbeatPingCommandHandler) Handle(ctx *contextplus.Context, command SHeartbeatPingCommand) (*entities.HealthCheckRequest, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if len(command.token) == 0 || !command.signal.IsValid() {
+		return nil, common.ErrorBadRequest
+	}
+
+	healthCheck, err := r.iUnitOfWork.HealthCheckRepository().FirstOrDefault(
+		ctx,
+		genericRepository.Equal("heartbeat_token", command.token),
+		genericRepository.Equal("type", enums.ProbeTypeHeartbeat),
+	)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in find heartbeat health check")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	if healthCheck == nil {
+		return nil, common.ErrorNotFound
+	}
+
+	var duration time.Duration
+	if command.signal != enums.HeartbeatSignalStart {
+		healthCheckRequests, err := r.iUnitOfWork.HealthCheckRequestRepository().Recent(ctx, healthCheck.Id, 1)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in get last heartbeat ping")
+
+			return nil, common.ErrorInternalServer
+		}
+
+		if len(healthCheckRequests) != 0 && healthCheckRequests[0].Status == enums.HeartbeatSignalStart.String() {
+			duration = time.Since(healthCheckRequests[0].CreatedAt)
+		}
+	}
+
+	healthCheckRequest := entities.NewHealthCheckHeartbeatRequest(healthCheck.Id, command.signal, command.log, duration)
+	if err = r.iUnitOfWork.HealthCheckRequestRepository().Create(ctx, &healthCheckRequest); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in create heartbeat ping")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return &healthCheckRequest, nil
+}
@@ -3,8 +3,10 @@ package commands
 import (
 	"github.com/ehsandavari/go-context-plus"
 	"health-check/domain/entities"
+	"health-check/domain/valueObjects"
 	"health-check/infrastructure"
 	"health-check/persistence"
+	"time"
 )
 
 type ICommand[T1 any, T2 any] interface {
@@ -13,14 +15,82 @@ type ICommand[T1 any, T2 any] interface {
 
 type Commands struct {
 	HealthCheckCreate ICommand[SHealthCheckCreateCommand, *entities.HealthCheck]
+	HealthCheckUpdate ICommand[SHealthCheckUpdateCommand, *entities.HealthCheck]
 	HealthCheckDelete ICommand[SHealthCheckDeleteCommand, *entities.HealthCheck]
 	HealthCheckStatus ICommand[SHealthCheckStatusCommand, *entities.HealthCheck]
+	HealthCheckImport ICommand[SHealthCheckImportCommand, []valueObjects.HealthCheckImportChange]
+
+	HeartbeatPing ICommand[SHeartbeatPingCommand, *entities.HealthCheckRequest]
+
+	HealthCheckDependencyCreate ICommand[SHealthCheckDependencyCreateCommand, *entities.HealthCheckDependency]
+	HealthCheckDependencyDelete ICommand[SHealthCheckDependencyDeleteCommand, *entities.HealthCheckDependency]
+
+	NotificationDeliveryRetry ICommand[SNotificationDeliveryRetryCommand, *entities.NotificationDelivery]
+
+	NotificationChannelCreate ICommand[SNotificationChannelCreateCommand, *entities.NotificationChannel]
+	NotificationChannelDelete ICommand[SNotificationChannelDeleteCommand, *entities.NotificationChannel]
+
+	EscalationPolicyCreate ICommand[SEscalationPolicyCreateCommand, *entities.EscalationPolicy]
+	EscalationPolicyDelete ICommand[SEscalationPolicyDeleteCommand, *entities.EscalationPolicy]
+
+	IncidentAcknowledge ICommand[SIncidentAcknowledgeCommand, *entities.Incident]
+
+	SilenceCreate ICommand[SSilenceCreateCommand, *entities.Silence]
+	SilenceExpire ICommand[SSilenceExpireCommand, *entities.Silence]
+
+	DigestCreate ICommand[SDigestCreateCommand, *entities.Digest]
+	DigestDelete ICommand[SDigestDeleteCommand, *entities.Digest]
+
+	RoleBindingCreate ICommand[SRoleBindingCreateCommand, *entities.RoleBinding]
+	RoleBindingDelete ICommand[SRoleBindingDeleteCommand, *entities.RoleBinding]
+
+	ApiKeyCreate       ICommand[SApiKeyCreateCommand, *entities.ApiKey]
+	ApiKeyRevoke       ICommand[SApiKeyRevokeCommand, *entities.ApiKey]
+	ApiKeyAuthenticate ICommand[SApiKeyAuthenticateCommand, *entities.ApiKey]
+
+	SecretCreate ICommand[SSecretCreateCommand, *entities.Secret]
+	SecretUpdate ICommand[SSecretUpdateCommand, *entities.Secret]
+	SecretDelete ICommand[SSecretDeleteCommand, *entities.Secret]
 }
 
 func NewCommands(infrastructure *infrastructure.Infrastructure, persistence *persistence.Persistence) Commands {
 	return Commands{
-		HealthCheckCreate: newHealthCheckCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.IRedis, persistence.IUnitOfWork),
+		HealthCheckCreate: newHealthCheckCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.IRedis, infrastructure.ICipher, persistence.IUnitOfWork, time.Duration(infrastructure.SConfig.Idempotency.TtlMinute)*time.Minute),
+		HealthCheckUpdate: newHealthCheckUpdateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.IRedis, infrastructure.ICipher, persistence.IUnitOfWork),
 		HealthCheckDelete: newHealthCheckDeleteCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.IRedis, persistence.IUnitOfWork),
 		HealthCheckStatus: newHealthCheckStatusCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.IRedis, persistence.IUnitOfWork),
+		HealthCheckImport: newHealthCheckImportCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.IRedis, infrastructure.ICipher, persistence.IUnitOfWork),
+
+		HeartbeatPing: newHeartbeatPingCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+
+		HealthCheckDependencyCreate: newHealthCheckDependencyCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+		HealthCheckDependencyDelete: newHealthCheckDependencyDeleteCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+
+		NotificationDeliveryRetry: newNotificationDeliveryRetryCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+
+		NotificationChannelCreate: newNotificationChannelCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+		NotificationChannelDelete: newNotificationChannelDeleteCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+
+		EscalationPolicyCreate: newEscalationPolicyCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+		EscalationPolicyDelete: newEscalationPolicyDeleteCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+
+		IncidentAcknowledge: newIncidentAcknowledgeCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+
+		SilenceCreate: newSilenceCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+		SilenceExpire: newSilenceExpireCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+
+		DigestCreate: newDigestCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.ICron, infrastructure.IRedis, persistence.IUnitOfWork),
+		DigestDelete: newDigestDeleteCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.IRedis, persistence.IUnitOfWork),
+
+		RoleBindingCreate: newRoleBindingCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+		RoleBindingDelete: newRoleBindingDeleteCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+
+		ApiKeyCreate:       newApiKeyCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+		ApiKeyRevoke:       newApiKeyRevokeCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+		ApiKeyAuthenticate: newApiKeyAuthenticateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
+
+		SecretCreate: newSecretCreateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.ICipher, persistence.IUnitOfWork),
+		SecretUpdate: newSecretUpdateCommandHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.ICipher, persistence.IUnitOfWork),
+		SecretDelete: newSecretDeleteCommandHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IUnitOfWork),
 	}
 }
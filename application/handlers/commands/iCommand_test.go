@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"go.uber.org/mock/gomock"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/tracer"
+	"testing"
+)
+
+type sMockCommandHandler struct {
+	iLogger                          *logger.MockILogger
+	iTracer                          *tracer.MockITracer
+	iSpan                            *tracer.MockISpan
+	iRedis                           *interfaces.MockIRedis
+	iCipher                          *interfaces.MockICipher
+	iHealthCheckRepository           *interfaces.MockIHealthCheckRepository
+	iHealthCheckDependencyRepository *interfaces.MockIHealthCheckDependencyRepository
+	iIncidentRepository              *interfaces.MockIIncidentRepository
+	iSilenceRepository               *interfaces.MockISilenceRepository
+	iAuditLogRepository              *interfaces.MockIAuditLogRepository
+	iApiKeyRepository                *interfaces.MockIApiKeyRepository
+	iEscalationPolicyRepository      *interfaces.MockIEscalationPolicyRepository
+	iHealthCheckLabelRepository      *interfaces.MockIHealthCheckLabelRepository
+	iTagRepository                   *interfaces.MockITagRepository
+	iUnitOfWork                      *interfaces.MockIUnitOfWork
+}
+
+func setup(t *testing.T) (mock *sMockCommandHandler) {
+	mockController := gomock.NewController(t)
+	mock = &sMockCommandHandler{
+		iLogger:                          logger.NewMockILogger(mockController),
+		iTracer:                          tracer.NewMockITracer(mockController),
+		iSpan:                            tracer.NewMockISpan(mockController),
+		iRedis:                           interfaces.NewMockIRedis(mockController),
+		iCipher:                          interfaces.NewMockICipher(mockController),
+		iHealthCheckRepository:           interfaces.NewMockIHealthCheckRepository(mockController),
+		iHealthCheckDependencyRepository: interfaces.NewMockIHealthCheckDependencyRepository(mockController),
+		iIncidentRepository:              interfaces.NewMockIIncidentRepository(mockController),
+		iSilenceRepository:               interfaces.NewMockISilenceRepository(mockController),
+		iAuditLogRepository:              interfaces.NewMockIAuditLogRepository(mockController),
+		iApiKeyRepository:                interfaces.NewMockIApiKeyRepository(mockController),
+		iEscalationPolicyRepository:      interfaces.NewMockIEscalationPolicyRepository(mockController),
+		iHealthCheckLabelRepository:      interfaces.NewMockIHealthCheckLabelRepository(mockController),
+		iTagRepository:                   interfaces.NewMockITagRepository(mockController),
+		iUnitOfWork:                      interfaces.NewMockIUnitOfWork(mockController),
+	}
+	t.Cleanup(func() {
+		mockController.Finish()
+	})
+	return mock
+}
+
+// expectDo runs the block of the unit of work against the same mocked unit of work.
+func (r *sMockCommandHandler) expectDo(ctx *contextplus.Context) {
+	r.iUnitOfWork.EXPECT().Do(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, unitOfWorkBlock func(interfaces.IUnitOfWork) error) error {
+		return unitOfWorkBlock(r.iUnitOfWork)
+	}).Times(1)
+}
+
+// expectSpan expects the spans the handler opens.
+func (r *sMockCommandHandler) expectSpan(ctx *contextplus.Context, times int) {
+	r.iTracer.EXPECT().SpanFromContext(ctx).Return(r.iSpan, ctx).Times(times)
+	r.iSpan.EXPECT().Finish().Times(times)
+}
+
+// expectAudit expects one audit log entry and hands it to check.
+func (r *sMockCommandHandler) expectAudit(ctx *contextplus.Context, check func(auditLog *entities.AuditLog)) {
+	r.iUnitOfWork.EXPECT().AuditLogRepository().Return(r.iAuditLogRepository).Times(1)
+	r.iAuditLogRepository.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, auditLog *entities.AuditLog) error {
+		if check != nil {
+			check(auditLog)
+		}
+		return nil
+	}).Times(1)
+}
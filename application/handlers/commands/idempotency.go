@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/google/uuid"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"time"
+)
+
+type idempotencyRecord struct {
+	RequestHash string
+	Response    json.RawMessage `json:",omitempty"`
+}
+
+// idempotent runs handle once per idempotency key, a repeat with the same request replays the stored response
+// and a repeat with another request, or while the first one is still running, is rejected with a conflict. redact
+// returns the copy of the result that is stored for the replays, so secrets of the result never reach redis.
+func idempotent[T any](ctx *contextplus.Context, iLogger logger.ILogger, iRedis interfaces.IRedis, ttl time.Duration, scope string, tenantId uuid.UUID, idempotencyKey string, request any, redact func(T) T, handle func() (T, error)) (result T, err error) {
+	if idempotencyKey == "" {
+		return handle()
+	}
+
+	if len(idempotencyKey) > 255 {
+		return result, common.ErrorBadRequest
+	}
+
+	var requestJson []byte
+	if requestJson, err = json.Marshal(request); err != nil {
+		iLogger.WithError(err).WithString("idempotencyKey", idempotencyKey).Error(ctx, "error in marshal idempotent request")
+
+		return result, common.ErrorInternalServer
+	}
+
+	requestHash := sha256.Sum256(requestJson)
+	record := idempotencyRecord{
+		RequestHash: hex.EncodeToString(requestHash[:]),
+	}
+	key := fmt.Sprintf("idempotency:%s:%s:%s", scope, tenantId, idempotencyKey)
+
+	var isClaimed bool
+	if isClaimed, err = iRedis.SetNX(ctx, key, record, ttl); err != nil {
+		iLogger.WithError(err).WithString("key", key).Error(ctx, "error in claim idempotency key")
+
+		return result, common.ErrorInternalServer
+	}
+
+	if !isClaimed {
+		var value string
+		if value, err = iRedis.Get(ctx, key); err != nil {
+			iLogger.WithError(err).WithString("key", key).Error(ctx, "error in get idempotency key")
+
+			return result, common.ErrorInternalServer
+		}
+
+		var stored idempotencyRecord
+		if value == "" {
+			return result, common.ErrorConflict
+		}
+		if err = json.Unmarshal([]byte(value), &stored); err != nil {
+			iLogger.WithError(err).WithString("key", key).Error(ctx, "error in unmarshal idempotency record")
+
+			return result, common.ErrorInternalServer
+		}
+
+		if stored.RequestHash != record.RequestHash || len(stored.Response) == 0 {
+			return result, common.ErrorConflict
+		}
+
+		if err = json.Unmarshal(stored.Response, &result); err != nil {
+			iLogger.WithError(err).WithString("key", key).Error(ctx, "error in unmarshal idempotent response")
+
+			return result, common.ErrorInternalServer
+		}
+
+		return result, nil
+	}
+
+	if result, err = handle(); err != nil {
+		if delErr := iRedis.Del(ctx, key); delErr != nil {
+			iLogger.WithError(delErr).WithString("key", key).Error(ctx, "error in release idempotency key")
+		}
+
+		return result, err
+	}
+
+	if record.Response, err = json.Marshal(redact(result)); err == nil {
+		err = iRedis.Set(ctx, key, record, ttl)
+	}
+	if err != nil {
+		iLogger.WithError(err).WithString("key", key).Error(ctx, "error in store idempotent response")
+	}
+
+	return result, nil
+}
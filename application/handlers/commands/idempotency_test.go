@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"health-check/application/common"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIdempotent(t *testing.T) {
+	const ttl = time.Hour
+	tenantId := uuid.New()
+	request := map[string]string{"name": "api"}
+	requestJson, _ := json.Marshal(request)
+	requestHash := sha256.Sum256(requestJson)
+	record := func(requestHash string, response string) string {
+		value, _ := json.Marshal(idempotencyRecord{RequestHash: requestHash, Response: json.RawMessage(response)})
+		return string(value)
+	}
+
+	type sArg struct {
+		idempotencyKey string
+		isClaimed      bool
+		stored         string
+		handleErr      error
+	}
+	type sOut struct {
+		result   int
+		isCalled bool
+		err      error
+	}
+	type sTableTest struct {
+		name string
+		arg  sArg
+		out  sOut
+	}
+
+	tableTests := []sTableTest{
+		{
+			name: "without an idempotency key",
+			out:  sOut{result: 1, isCalled: true},
+		},
+		{
+			name: "too long idempotency key",
+			arg:  sArg{idempotencyKey: strings.Repeat("k", 256)},
+			out:  sOut{err: common.ErrorBadRequest},
+		},
+		{
+			name: "first request stores the redacted result",
+			arg:  sArg{idempotencyKey: "key", isClaimed: true},
+			out:  sOut{result: 1, isCalled: true},
+		},
+		{
+			name: "first request fails",
+			arg:  sArg{idempotencyKey: "key", isClaimed: true, handleErr: common.ErrorNotFound},
+			out:  sOut{isCalled: true, err: common.ErrorNotFound},
+		},
+		{
+			name: "replay",
+			arg:  sArg{idempotencyKey: "key", stored: record(hex.EncodeToString(requestHash[:]), "7")},
+			out:  sOut{result: 7},
+		},
+		{
+			name: "another request with the same key",
+			arg:  sArg{idempotencyKey: "key", stored: record("other", "7")},
+			out:  sOut{err: common.ErrorConflict},
+		},
+		{
+			name: "first request still in flight",
+			arg:  sArg{idempotencyKey: "key", stored: record(hex.EncodeToString(requestHash[:]), "")},
+			out:  sOut{err: common.ErrorConflict},
+		},
+		{
+			name: "key expired between claim and get",
+			arg:  sArg{idempotencyKey: "key"},
+			out:  sOut{err: common.ErrorConflict},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			ctx := contextplus.Background()
+			key := fmt.Sprintf("idempotency:healthCheckCreate:%s:%s", tenantId, tableTest.arg.idempotencyKey)
+
+			if tableTest.arg.idempotencyKey != "" && len(tableTest.arg.idempotencyKey) <= 255 {
+				mock.iRedis.EXPECT().SetNX(ctx, key, idempotencyRecord{RequestHash: hex.EncodeToString(requestHash[:])}, ttl).Return(tableTest.arg.isClaimed, nil).Times(1)
+				if !tableTest.arg.isClaimed {
+					mock.iRedis.EXPECT().Get(ctx, key).Return(tableTest.arg.stored, nil).Times(1)
+				}
+			}
+			if tableTest.arg.isClaimed && tableTest.arg.handleErr == nil {
+				mock.iRedis.EXPECT().Set(ctx, key, idempotencyRecord{RequestHash: hex.EncodeToString(requestHash[:]), Response: json.RawMessage("-1")}, ttl).Return(nil).Times(1)
+			}
+			if tableTest.arg.handleErr != nil {
+				mock.iRedis.EXPECT().Del(ctx, key).Return(nil).Times(1)
+			}
+
+			var isCalled bool
+			result, err := idempotent(ctx, mock.iLogger, mock.iRedis, ttl, "healthCheckCreate", tenantId, tableTest.arg.idempotencyKey, request, func(result int) int {
+				return -result
+			}, func() (int, error) {
+				isCalled = true
+				if tableTest.arg.handleErr != nil {
+					return 0, tableTest.arg.handleErr
+				}
+				return 1, nil
+			})
+
+			assert.Equal(t, tableTest.out.err, err)
+			assert.Equal(t, tableTest.out.result, result)
+			assert.Equal(t, tableTest.out.isCalled, isCalled)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SIncidentAcknowledgeCommand struct {
+	tenantId uuid.UUID
+	id       uint
+	userId   uuid.UUID
+}
+
+func NewIncidentAcknowledgeCommand(tenantId uuid.UUID, id uint, userId uuid.UUID) SIncidentAcknowledgeCommand {
+	return SIncidentAcknowledgeCommand{
+		tenantId: tenantId,
+		id:       id,
+		userId:   userId,
+	}
+}
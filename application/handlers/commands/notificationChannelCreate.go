@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+)
+
+type SNotificationChannelCreateCommand struct {
+	tenantId uuid.UUID
+	name     string
+	provider enums.NotificationProvider
+	receiver string
+	labels   map[string]string
+}
+
+func NewNotificationChannelCreateCommand(tenantId uuid.UUID, name string, provider enums.NotificationProvider, receiver string, labels map[string]string) SNotificationChannelCreateCommand {
+	return SNotificationChannelCreateCommand{
+		tenantId: tenantId,
+		name:     name,
+		provider: provider,
+		receiver: receiver,
+		labels:   labels,
+	}
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SNotificationChannelCreateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newNotificationChannelCreateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SNotificationChannelCreateCommandHandler {
+	return SNotificationChannelCreateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SNotificationChannelCreateCommandHandler) Handle(ctx *contextplus.Context, command SNotificationChannelCreateCommand) (*entities.NotificationChannel, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	notificationChannel := entities.NewNotificationChannel(command.tenantId, command.name, command.provider, command.receiver, command.labels)
+	if err := r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		exists, err := iUnitOfWork.NotificationChannelRepository().Exists(ctx, genericRepository.Equal("name", command.name), genericRepository.Equal("tenant_id", command.tenantId))
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find notification channel")
+
+			return common.ErrorInternalServer
+		}
+
+		if exists {
+			return common.ErrorBadRequest
+		}
+
+		if err = iUnitOfWork.NotificationChannelRepository().Create(ctx, &notificationChannel); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).WithAny("notificationChannel", notificationChannel).Error(ctx, "error in create new notification channel")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionCreate, "notificationChannel", notificationChannel.Id, nil, notificationChannel); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &notificationChannel, nil
+}
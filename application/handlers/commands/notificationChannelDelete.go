@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SNotificationChannelDeleteCommand struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewNotificationChannelDeleteCommand(tenantId uuid.UUID, id uint) SNotificationChannelDeleteCommand {
+	return SNotificationChannelDeleteCommand{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
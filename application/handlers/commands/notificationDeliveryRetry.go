@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SNotificationDeliveryRetryCommand struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewNotificationDeliveryRetryCommand(tenantId uuid.UUID, id uint) SNotificationDeliveryRetryCommand {
+	return SNotificationDeliveryRetryCommand{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SNotificationDeliveryRetryCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newNotificationDeliveryRetryCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SNotificationDeliveryRetryCommandHandler {
+	return SNotificationDeliveryRetryCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SNotificationDeliveryRetryCommandHandler) Handle(ctx *contextplus.Context, command SNotificationDeliveryRetryCommand) (notificationDelivery *entities.NotificationDelivery, err error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if notificationDelivery, err = iUnitOfWork.NotificationDeliveryRepository().SingleOrDefault(
+			ctx,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in find notification delivery")
+
+			return common.ErrorInternalServer
+		}
+
+		if notificationDelivery == nil {
+			return common.ErrorNotFound
+		}
+
+		before := *notificationDelivery
+
+		if notificationDelivery.Status != enums.DeliveryStatusDead {
+			return common.ErrorBadRequest
+		}
+
+		notificationDelivery.Retry()
+
+		if notificationDelivery, err = iUnitOfWork.NotificationDeliveryRepository().Save(
+			ctx,
+			notificationDelivery,
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in retry notification delivery")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionUpdate, "notificationDelivery", before.Id, before, notificationDelivery); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return notificationDelivery, nil
+}
@@ -0,0 +1,22 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+)
+
+type SRoleBindingCreateCommand struct {
+	tenantId  uuid.UUID
+	userId    uuid.UUID
+	role      enums.Role
+	createdBy uuid.UUID
+}
+
+func NewRoleBindingCreateCommand(tenantId uuid.UUID, userId uuid.UUID, role enums.Role, createdBy uuid.UUID) SRoleBindingCreateCommand {
+	return SRoleBindingCreateCommand{
+		tenantId:  tenantId,
+		userId:    userId,
+		role:      role,
+		createdBy: createdBy,
+	}
+}
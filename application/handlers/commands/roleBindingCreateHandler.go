@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SRoleBindingCreateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newRoleBindingCreateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SRoleBindingCreateCommandHandler {
+	return SRoleBindingCreateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SRoleBindingCreateCommandHandler) Handle(ctx *contextplus.Context, command SRoleBindingCreateCommand) (*entities.RoleBinding, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	roleBinding := entities.NewRoleBinding(command.tenantId, command.userId, command.role, command.createdBy)
+	if err := r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		exists, err := iUnitOfWork.RoleBindingRepository().Exists(
+			ctx,
+			genericRepository.Equal("tenant_id", command.tenantId),
+			genericRepository.Equal("user_id", command.userId),
+		)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find role binding")
+
+			return common.ErrorInternalServer
+		}
+
+		if exists {
+			return common.ErrorBadRequest
+		}
+
+		if err = iUnitOfWork.RoleBindingRepository().Create(ctx, &roleBinding); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).WithAny("roleBinding", roleBinding).Error(ctx, "error in create new role binding")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionCreate, "roleBinding", roleBinding.Id, nil, roleBinding); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &roleBinding, nil
+}
@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SRoleBindingDeleteCommand struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewRoleBindingDeleteCommand(tenantId uuid.UUID, id uint) SRoleBindingDeleteCommand {
+	return SRoleBindingDeleteCommand{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
@@ -0,0 +1,19 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SSecretCreateCommand struct {
+	tenantId    uuid.UUID
+	name        string
+	description string
+	value       string
+}
+
+func NewSecretCreateCommand(tenantId uuid.UUID, name string, description string, value string) SSecretCreateCommand {
+	return SSecretCreateCommand{
+		tenantId:    tenantId,
+		name:        name,
+		description: description,
+		value:       value,
+	}
+}
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SSecretCreateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iCipher     interfaces.ICipher
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newSecretCreateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iCipher interfaces.ICipher,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SSecretCreateCommandHandler {
+	return SSecretCreateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iCipher:     iCipher,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SSecretCreateCommandHandler) Handle(ctx *contextplus.Context, command SSecretCreateCommand) (*entities.Secret, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	value, err := r.iCipher.Encrypt(command.tenantId, entities.SecretField(command.name), command.value)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in seal secret")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	secret := entities.NewSecret(command.tenantId, command.name, command.description, value)
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		exists, err := iUnitOfWork.SecretRepository().Exists(
+			ctx,
+			genericRepository.Equal("tenant_id", command.tenantId),
+			genericRepository.Equal("name", command.name),
+		)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("secret", secret).Error(ctx, "error in find secret")
+
+			return common.ErrorInternalServer
+		}
+
+		if exists {
+			return common.ErrorBadRequest
+		}
+
+		if err = iUnitOfWork.SecretRepository().Create(ctx, &secret); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("secret", secret).Error(ctx, "error in create new secret")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionCreate, "secret", secret.Id, nil, secret); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("secret", secret).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SSecretDeleteCommand struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewSecretDeleteCommand(tenantId uuid.UUID, id uint) SSecretDeleteCommand {
+	return SSecretDeleteCommand{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
@@ -0,0 +1,19 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SSecretUpdateCommand struct {
+	tenantId    uuid.UUID
+	id          uint
+	description string
+	value       *string
+}
+
+func NewSecretUpdateCommand(tenantId uuid.UUID, id uint, description string, value *string) SSecretUpdateCommand {
+	return SSecretUpdateCommand{
+		tenantId:    tenantId,
+		id:          id,
+		description: description,
+		value:       value,
+	}
+}
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SSecretUpdateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iCipher     interfaces.ICipher
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newSecretUpdateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iCipher interfaces.ICipher,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SSecretUpdateCommandHandler {
+	return SSecretUpdateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iCipher:     iCipher,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+// Handle changes the description of the secret and, when a value is given, replaces the sealed value.
+func (r SSecretUpdateCommandHandler) Handle(ctx *contextplus.Context, command SSecretUpdateCommand) (secret *entities.Secret, err error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if secret, err = iUnitOfWork.SecretRepository().SingleOrDefault(
+			ctx,
+			genericRepository.Equal("id", command.id),
+			genericRepository.Equal("tenant_id", command.tenantId),
+		); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in find secret")
+
+			return common.ErrorInternalServer
+		}
+
+		if secret == nil {
+			return common.ErrorNotFound
+		}
+
+		before := *secret
+
+		secret.Description = command.description
+		if command.value != nil {
+			// the value is sealed for the name of the secret, so it is sealed only once the secret is found
+			if secret.Value, err = r.iCipher.Encrypt(secret.TenantId, entities.SecretField(secret.Name), *command.value); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in seal secret")
+
+				return common.ErrorInternalServer
+			}
+		}
+
+		if secret, err = iUnitOfWork.SecretRepository().Save(ctx, secret); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in update secret")
+
+			return common.ErrorInternalServer
+		}
+
+		if err = audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionUpdate, "secret", before.Id, before, secret); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("id", command.id).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
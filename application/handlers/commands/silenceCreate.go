@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SSilenceCreateCommand struct {
+	tenantId      uuid.UUID
+	healthCheckId *uint
+	tag           *string
+	labels        map[string]string
+	reason        string
+	duration      time.Duration
+	userId        uuid.UUID
+}
+
+func NewSilenceCreateCommand(tenantId uuid.UUID, healthCheckId *uint, tag *string, labels map[string]string, reason string, duration time.Duration, userId uuid.UUID) SSilenceCreateCommand {
+	return SSilenceCreateCommand{
+		tenantId:      tenantId,
+		healthCheckId: healthCheckId,
+		tag:           tag,
+		labels:        labels,
+		reason:        reason,
+		duration:      duration,
+		userId:        userId,
+	}
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SSilenceCreateCommandHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newSilenceCreateCommandHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SSilenceCreateCommandHandler {
+	return SSilenceCreateCommandHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+func (r SSilenceCreateCommandHandler) Handle(ctx *contextplus.Context, command SSilenceCreateCommand) (*entities.Silence, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	targets := 0
+	for _, target := range []bool{command.healthCheckId != nil, command.tag != nil, len(command.labels) > 0} {
+		if target {
+			targets++
+		}
+	}
+	if targets != 1 || command.duration <= 0 {
+		return nil, common.ErrorBadRequest
+	}
+
+	silence := entities.NewSilence(command.tenantId, command.healthCheckId, command.tag, command.labels, command.reason, command.userId, command.duration)
+	if err := r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if command.healthCheckId != nil {
+			exists, err := iUnitOfWork.HealthCheckRepository().Exists(ctx, genericRepository.Equal("id", *command.healthCheckId), genericRepository.Equal("tenant_id", command.tenantId))
+			if err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in find health check")
+
+				return common.ErrorInternalServer
+			}
+
+			if !exists {
+				return common.ErrorBadRequest
+			}
+		}
+
+		if err := iUnitOfWork.SilenceRepository().Create(ctx, &silence); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).WithAny("silence", silence).Error(ctx, "error in create new silence")
+
+			return common.ErrorInternalServer
+		}
+
+		if err := audit(ctx, iUnitOfWork, command.tenantId, enums.AuditActionCreate, "silence", silence.Id, nil, silence); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("command", command).Error(ctx, "error in write audit log")
+
+			return common.ErrorInternalServer
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &silence, nil
+}
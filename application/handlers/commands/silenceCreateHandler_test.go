@@ -0,0 +1,205 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"testing"
+	"time"
+)
+
+func TestSilenceCreateHandle(t *testing.T) {
+	tenantId := uuid.New()
+	healthCheckId := uint(3)
+	tag := "database"
+
+	type (
+		sIn struct {
+			ctx     *contextplus.Context
+			command SSilenceCreateCommand
+		}
+		sOut struct {
+			silence *entities.Silence
+			err     error
+		}
+		sArg struct {
+			in  sIn
+			out sOut
+		}
+		sTableTest struct {
+			name   string
+			arg    sArg
+			mock   func(mock *sMockCommandHandler, arg sIn)
+			assert func(t *testing.T, arg sOut)
+		}
+	)
+
+	tableTests := []sTableTest{
+		{
+			name: "silence without a target",
+			arg: sArg{
+				in: sIn{
+					ctx:     contextplus.Background(),
+					command: NewSilenceCreateCommand(tenantId, nil, nil, nil, "maintenance", time.Hour, tenantId),
+				},
+			},
+			mock: func(mock *sMockCommandHandler, arg sIn) {
+				mock.expectSpan(arg.ctx, 1)
+			},
+			assert: func(t *testing.T, arg sOut) {
+				assert.Equal(t, common.ErrorBadRequest, arg.err)
+			},
+		},
+		{
+			name: "silence with more than one target",
+			arg: sArg{
+				in: sIn{
+					ctx:     contextplus.Background(),
+					command: NewSilenceCreateCommand(tenantId, &healthCheckId, &tag, nil, "maintenance", time.Hour, tenantId),
+				},
+			},
+			mock: func(mock *sMockCommandHandler, arg sIn) {
+				mock.expectSpan(arg.ctx, 1)
+			},
+			assert: func(t *testing.T, arg sOut) {
+				assert.Equal(t, common.ErrorBadRequest, arg.err)
+			},
+		},
+		{
+			name: "silence without a duration",
+			arg: sArg{
+				in: sIn{
+					ctx:     contextplus.Background(),
+					command: NewSilenceCreateCommand(tenantId, nil, &tag, nil, "maintenance", 0, tenantId),
+				},
+			},
+			mock: func(mock *sMockCommandHandler, arg sIn) {
+				mock.expectSpan(arg.ctx, 1)
+			},
+			assert: func(t *testing.T, arg sOut) {
+				assert.Equal(t, common.ErrorBadRequest, arg.err)
+			},
+		},
+		{
+			name: "silence of a health check of another tenant",
+			arg: sArg{
+				in: sIn{
+					ctx:     contextplus.Background(),
+					command: NewSilenceCreateCommand(tenantId, &healthCheckId, nil, nil, "maintenance", time.Hour, tenantId),
+				},
+			},
+			mock: func(mock *sMockCommandHandler, arg sIn) {
+				mock.expectSpan(arg.ctx, 1)
+				mock.expectDo(arg.ctx)
+				mock.iUnitOfWork.EXPECT().HealthCheckRepository().Return(mock.iHealthCheckRepository).Times(1)
+				mock.iHealthCheckRepository.EXPECT().Exists(arg.ctx, gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+			},
+			assert: func(t *testing.T, arg sOut) {
+				assert.Equal(t, common.ErrorBadRequest, arg.err)
+			},
+		},
+		{
+			name: "silence of a tag",
+			arg: sArg{
+				in: sIn{
+					ctx:     contextplus.Background(),
+					command: NewSilenceCreateCommand(tenantId, nil, &tag, nil, "maintenance", time.Hour, tenantId),
+				},
+			},
+			mock: func(mock *sMockCommandHandler, arg sIn) {
+				mock.expectSpan(arg.ctx, 1)
+				mock.expectDo(arg.ctx)
+				mock.iUnitOfWork.EXPECT().SilenceRepository().Return(mock.iSilenceRepository).Times(1)
+				mock.iSilenceRepository.EXPECT().Create(arg.ctx, gomock.Any()).Return(nil).Times(1)
+				mock.expectAudit(arg.ctx, func(auditLog *entities.AuditLog) {
+					assert.Equal(t, enums.AuditActionCreate, auditLog.Action)
+					assert.Equal(t, "silence", auditLog.Entity)
+				})
+			},
+			assert: func(t *testing.T, arg sOut) {
+				assert.NoError(t, arg.err)
+				assert.Equal(t, tag, *arg.silence.Tag)
+				assert.True(t, arg.silence.IsActive(time.Now()))
+				assert.False(t, arg.silence.IsActive(time.Now().Add(2*time.Hour)))
+			},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			silenceCreateCommandHandler := newSilenceCreateCommandHandler(mock.iLogger, mock.iTracer, mock.iUnitOfWork)
+
+			tableTest.mock(mock, tableTest.arg.in)
+
+			silence, err := silenceCreateCommandHandler.Handle(tableTest.arg.in.ctx, tableTest.arg.in.command)
+
+			tableTest.assert(t, sOut{silence: silence, err: err})
+		})
+	}
+}
+
+func TestIncidentAcknowledgeHandle(t *testing.T) {
+	tenantId := uuid.New()
+	userId := uuid.New()
+
+	tableTests := []struct {
+		name     string
+		incident *entities.Incident
+		err      error
+	}{
+		{
+			name: "unknown incident",
+			err:  common.ErrorNotFound,
+		},
+		{
+			name: "acknowledged incident",
+			incident: func() *entities.Incident {
+				incident := entities.NewIncident(tenantId, 1, true)
+				incident.Acknowledge(userId)
+				return &incident
+			}(),
+			err: common.ErrorBadRequest,
+		},
+		{
+			name: "open incident",
+			incident: func() *entities.Incident {
+				incident := entities.NewIncident(tenantId, 1, true)
+				return &incident
+			}(),
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			incidentAcknowledgeCommandHandler := newIncidentAcknowledgeCommandHandler(mock.iLogger, mock.iTracer, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+
+			mock.expectSpan(ctx, 1)
+			mock.expectDo(ctx)
+			mock.iUnitOfWork.EXPECT().IncidentRepository().Return(mock.iIncidentRepository).MinTimes(1)
+			mock.iIncidentRepository.EXPECT().SingleOrDefault(ctx, gomock.Any(), gomock.Any()).Return(tableTest.incident, nil).Times(1)
+			if tableTest.err == nil {
+				mock.iIncidentRepository.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, incident *entities.Incident) (*entities.Incident, error) {
+					return incident, nil
+				}).Times(1)
+				mock.expectAudit(ctx, nil)
+			}
+
+			incident, err := incidentAcknowledgeCommandHandler.Handle(ctx, NewIncidentAcknowledgeCommand(tenantId, 1, userId))
+
+			assert.Equal(t, tableTest.err, err)
+			if tableTest.err != nil {
+				return
+			}
+			assert.Equal(t, enums.IncidentStatusAcknowledged, incident.Status)
+			assert.Equal(t, userId, *incident.AcknowledgedBy)
+			assert.Nil(t, incident.NextEscalationAt)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/google/uuid"
+
+type SSilenceExpireCommand struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewSilenceExpireCommand(tenantId uuid.UUID, id uint) SSilenceExpireCommand {
+	return SSilenceExpireCommand{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
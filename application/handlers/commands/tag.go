@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"slices"
+)
+
+// findOrCreateTags returns the tags of the tenant with the given names, creating the ones the tenant does not have yet.
+func findOrCreateTags(ctx *contextplus.Context, iUnitOfWork interfaces.IUnitOfWork, tenantId uuid.UUID, names []string) ([]entities.Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	tags, err := iUnitOfWork.TagRepository().All(ctx, genericRepository.Equal("tenant_id", tenantId), genericRepository.In("name", names...))
+	if err != nil {
+		return nil, err
+	}
+
+	var newTags []entities.Tag
+	for _, name := range names {
+		if !slices.ContainsFunc(tags, func(tag entities.Tag) bool { return tag.Name == name }) &&
+			!slices.ContainsFunc(newTags, func(tag entities.Tag) bool { return tag.Name == name }) {
+			newTags = append(newTags, entities.NewTag(tenantId, name))
+		}
+	}
+
+	if len(newTags) > 0 {
+		if newTags, err = iUnitOfWork.TagRepository().Creates(ctx, newTags...); err != nil {
+			return nil, err
+		}
+	}
+
+	return append(tags, newTags...), nil
+}
@@ -0,0 +1,355 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"slices"
+	"strings"
+	"time"
+)
+
+const _digestSlowestCount = 5
+
+type SDigestJobHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iRedis      interfaces.IRedis
+	iCron       interfaces.ICron
+	iUnitOfWork interfaces.IUnitOfWork
+
+	callAddJob   func(ctx *contextplus.Context, digest entities.Digest)
+	callSubRedis func(ctx *contextplus.Context)
+	callSend     func(ctx *contextplus.Context, digest entities.Digest)
+
+	digestChannel chan string
+}
+
+func newDigestJobHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iRedis interfaces.IRedis,
+	iCron interfaces.ICron,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SDigestJobHandler {
+	s := SDigestJobHandler{
+		iLogger:       iLogger,
+		iTracer:       iTracer,
+		iRedis:        iRedis,
+		iCron:         iCron,
+		iUnitOfWork:   iUnitOfWork,
+		digestChannel: make(chan string),
+	}
+	s.callAddJob = s.addJob
+	s.callSubRedis = s.subRedis
+	s.callSend = s.send
+	return s
+}
+
+func (r SDigestJobHandler) Start(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	digests, err := r.iUnitOfWork.DigestRepository().All(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in get all digests")
+
+		return err
+	}
+
+	for _, digest := range digests {
+		r.callAddJob(ctx, digest)
+	}
+
+	go r.callSubRedis(ctx)
+
+	return nil
+}
+
+func (r SDigestJobHandler) Stop(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	close(r.digestChannel)
+
+	return nil
+}
+
+func (r SDigestJobHandler) addJob(ctx *contextplus.Context, digest entities.Digest) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	key := fmt.Sprintf("digest:%d", digest.Id)
+
+	if digest.DeletedAt.Valid {
+		r.iCron.RemoveFunc(key)
+		return
+	}
+
+	if err := r.iCron.AddFunc(key, digest.Schedule, func() {
+		r.callSend(ctx, digest)
+	}); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("digestId", digest.Id).Error(ctx, "error in add digest job")
+
+		return
+	}
+}
+
+func (r SDigestJobHandler) subRedis(ctx *contextplus.Context) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	go r.iRedis.Subscribe(ctx, "digest", r.digestChannel)
+
+	for c := range r.digestChannel {
+		var digest entities.Digest
+		if err := json.Unmarshal([]byte(c), &digest); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithString("data", c).Error(ctx, "error in json unmarshal to digest entity")
+
+			continue
+		}
+
+		r.callAddJob(ctx, digest)
+	}
+}
+
+// send builds and enqueues the report of the period ending now. Every replica runs the cron of the digest, so the
+// first one to claim the period in redis sends it and the others skip it.
+func (r SDigestJobHandler) send(ctx *contextplus.Context, digest entities.Digest) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	to := time.Now()
+	from := to.Add(-digest.Period.Duration())
+
+	isClaimed, err := r.iRedis.SetNX(ctx, fmt.Sprintf("digest:%d:%d", digest.Id, to.Truncate(time.Minute).Unix()), true, digest.Period.Duration())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("digestId", digest.Id).Error(ctx, "error in claim digest period")
+
+		return
+	}
+
+	if !isClaimed {
+		return
+	}
+
+	notificationChannel, err := r.iUnitOfWork.NotificationChannelRepository().FirstOrDefault(ctx, genericRepository.Equal("id", digest.NotificationChannelId), genericRepository.Equal("tenant_id", digest.TenantId))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("digestId", digest.Id).Error(ctx, "error in find digest notification channel")
+
+		return
+	}
+
+	if notificationChannel == nil {
+		r.iLogger.WithUint("digestId", digest.Id).WithUint("notificationChannelId", digest.NotificationChannelId).Warn(ctx, "digest notification channel not found")
+		return
+	}
+
+	report, err := r.report(ctx, digest, from, to)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("digestId", digest.Id).Error(ctx, "error in build digest report")
+
+		return
+	}
+
+	if err = enqueueChannelNotification(
+		ctx,
+		r.iUnitOfWork,
+		*notificationChannel,
+		fmt.Sprintf("%s digest | %s - %s", digest.Period, from.Format(time.DateTime), to.Format(time.DateTime)),
+		report.String(),
+	); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("digestId", digest.Id).Error(ctx, "error in enqueue digest notification")
+	}
+}
+
+type sDigestReport struct {
+	tag                *string
+	labels             map[string]string
+	healthChecks       int
+	uptime             float64
+	incidents          int
+	meanTimeToRecovery time.Duration
+	slowest            []sDigestSlowCheck
+}
+
+type sDigestSlowCheck struct {
+	healthCheckId              uint
+	name                       string
+	url                        string
+	averageDurationMillisecond float64
+}
+
+func (r sDigestReport) String() string {
+	var builder strings.Builder
+	if r.tag != nil {
+		fmt.Fprintf(&builder, "tag : %s\n", *r.tag)
+	}
+	if len(r.labels) > 0 {
+		selectors := make([]string, 0, len(r.labels))
+		for key, value := range r.labels {
+			selectors = append(selectors, key+"="+value)
+		}
+		slices.Sort(selectors)
+		fmt.Fprintf(&builder, "labels : %s\n", strings.Join(selectors, ","))
+	}
+	fmt.Fprintf(&builder, "health checks : %d\n", r.healthChecks)
+	fmt.Fprintf(&builder, "uptime : %.2f%%\n", r.uptime)
+	fmt.Fprintf(&builder, "incidents : %d\n", r.incidents)
+	fmt.Fprintf(&builder, "mean time to recovery : %s\n", r.meanTimeToRecovery.Round(time.Second))
+	if len(r.slowest) > 0 {
+		builder.WriteString("slowest checks :\n")
+		for _, slowCheck := range r.slowest {
+			fmt.Fprintf(&builder, "- id : %d | name : %s | url : %s | average : %.0fms\n", slowCheck.healthCheckId, slowCheck.name, slowCheck.url, slowCheck.averageDurationMillisecond)
+		}
+	}
+	return builder.String()
+}
+
+func (r SDigestJobHandler) report(ctx *contextplus.Context, digest entities.Digest, from time.Time, to time.Time) (*sDigestReport, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	report := &sDigestReport{
+		tag:    digest.Tag,
+		labels: digest.Labels.Data(),
+		uptime: 100,
+	}
+
+	// a selector matching no health check leaves the report empty
+	specifications := []genericRepository.Specification{
+		genericRepository.Equal("tenant_id", digest.TenantId),
+	}
+	if digest.Tag != nil {
+		ids, err := r.iUnitOfWork.TagRepository().HealthCheckIds(ctx, digest.TenantId, []string{*digest.Tag})
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return report, nil
+		}
+		specifications = append(specifications, genericRepository.In("id", ids...))
+	}
+	if len(digest.Labels.Data()) > 0 {
+		ids, err := r.iUnitOfWork.HealthCheckLabelRepository().HealthCheckIds(ctx, digest.Labels.Data())
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return report, nil
+		}
+		specifications = append(specifications, genericRepository.In("id", ids...))
+	}
+
+	healthChecks, err := r.iUnitOfWork.HealthCheckRepository().All(ctx, specifications...)
+	if err != nil {
+		return nil, err
+	}
+
+	healthCheckIds := make([]uint, 0, len(healthChecks))
+	for _, healthCheck := range healthChecks {
+		healthCheckIds = append(healthCheckIds, healthCheck.Id)
+	}
+
+	report.healthChecks = len(healthCheckIds)
+	if len(healthCheckIds) == 0 {
+		return report, nil
+	}
+
+	healthCheckStats, err := r.iUnitOfWork.HealthCheckRequestRepository().Stats(ctx, healthCheckIds, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var total, succeeded int64
+	for _, stats := range healthCheckStats {
+		total += stats.Total
+		succeeded += stats.Succeeded
+	}
+	if total > 0 {
+		report.uptime = float64(succeeded) * 100 / float64(total)
+	}
+
+	incidents, err := r.iUnitOfWork.IncidentRepository().All(
+		ctx,
+		genericRepository.In("health_check_id", healthCheckIds...),
+		genericRepository.GreaterOrEqual("created_at", from),
+		genericRepository.LessThan("created_at", to),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report.incidents = len(incidents)
+
+	var recovery time.Duration
+	var recovered int
+	for _, incident := range incidents {
+		if incident.ResolvedAt != nil {
+			recovery += incident.ResolvedAt.Sub(incident.CreatedAt)
+			recovered++
+		}
+	}
+	if recovered > 0 {
+		report.meanTimeToRecovery = recovery / time.Duration(recovered)
+	}
+
+	slices.SortFunc(healthCheckStats, func(a, b valueObjects.HealthCheckStats) int {
+		switch {
+		case a.AverageDurationMillisecond > b.AverageDurationMillisecond:
+			return -1
+		case a.AverageDurationMillisecond < b.AverageDurationMillisecond:
+			return 1
+		default:
+			return 0
+		}
+	})
+	healthCheckStats = healthCheckStats[:min(len(healthCheckStats), _digestSlowestCount)]
+	if len(healthCheckStats) == 0 {
+		return report, nil
+	}
+
+	slowestIds := make([]uint, 0, len(healthCheckStats))
+	for _, stats := range healthCheckStats {
+		slowestIds = append(slowestIds, stats.HealthCheckId)
+	}
+
+	slowestHealthChecks, err := r.iUnitOfWork.HealthCheckRepository().All(ctx, genericRepository.In("id", slowestIds...))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stats := range healthCheckStats {
+		slowCheck := sDigestSlowCheck{
+			healthCheckId:              stats.HealthCheckId,
+			averageDurationMillisecond: stats.AverageDurationMillisecond,
+		}
+		if i := slices.IndexFunc(slowestHealthChecks, func(healthCheck entities.HealthCheck) bool { return healthCheck.Id == stats.HealthCheckId }); i >= 0 {
+			slowCheck.name = slowestHealthChecks[i].Name
+			slowCheck.url = slowestHealthChecks[i].Url
+		}
+		report.slowest = append(report.slowest, slowCheck)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,126 @@
+package jobs
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDigestReport(t *testing.T) {
+	tag := "payments"
+	to := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	from := to.Add(-enums.DigestPeriodDaily.Duration())
+	healthChecks := []entities.HealthCheck{
+		{Id: 1, Name: "api", Url: "https://api.internal"},
+		{Id: 2, Name: "checkout", Url: "https://checkout.internal"},
+	}
+	resolvedAt := from.Add(3 * time.Hour)
+	incidents := []entities.Incident{
+		{Id: 1, HealthCheckId: 1, ResolvedAt: &resolvedAt, Base3: entities.Base3{CreatedAt: from.Add(time.Hour)}},
+		{Id: 2, HealthCheckId: 2, ResolvedAt: &resolvedAt, Base3: entities.Base3{CreatedAt: from.Add(2 * time.Hour)}},
+		{Id: 3, HealthCheckId: 2, Base3: entities.Base3{CreatedAt: from.Add(4 * time.Hour)}},
+	}
+
+	tableTests := []struct {
+		name         string
+		healthChecks []entities.HealthCheck
+		stats        []valueObjects.HealthCheckStats
+		want         string
+	}{
+		{
+			name: "tag without health checks reads nothing else",
+			want: "tag : payments\nhealth checks : 0\nuptime : 100.00%\nincidents : 0\nmean time to recovery : 0s\n",
+		},
+		{
+			name:         "tag batches the stats of its health checks",
+			healthChecks: healthChecks,
+			stats: []valueObjects.HealthCheckStats{
+				{HealthCheckId: 1, Total: 100, Succeeded: 99, AverageDurationMillisecond: 40},
+				{HealthCheckId: 2, Total: 100, Succeeded: 95, AverageDurationMillisecond: 120},
+			},
+			want: "tag : payments\nhealth checks : 2\nuptime : 97.00%\nincidents : 3\nmean time to recovery : 1h30m0s\n" +
+				"slowest checks :\n" +
+				"- id : 2 | name : checkout | url : https://checkout.internal | average : 120ms\n" +
+				"- id : 1 | name : api | url : https://api.internal | average : 40ms\n",
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			digestJobHandler := newDigestJobHandler(mock.iLogger, mock.iTracer, mock.iRedis, mock.iCron, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+			digest := entities.NewDigest([16]byte{}, 1, "@daily", enums.DigestPeriodDaily, &tag, nil)
+
+			ids := make([]uint, 0, len(tableTest.healthChecks))
+			for _, healthCheck := range tableTest.healthChecks {
+				ids = append(ids, healthCheck.Id)
+			}
+
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+			mock.iSpan.EXPECT().Finish().Times(1)
+			mock.iUnitOfWork.EXPECT().TagRepository().Return(mock.iTagRepository).Times(1)
+			mock.iTagRepository.EXPECT().HealthCheckIds(ctx, digest.TenantId, []string{tag}).Return(ids, nil).Times(1)
+			if len(ids) > 0 {
+				mock.iUnitOfWork.EXPECT().HealthCheckRepository().Return(mock.iHealthCheckRepository).MinTimes(1)
+				mock.iHealthCheckRepository.EXPECT().All(ctx, gomock.Any(), gomock.Any()).Return(tableTest.healthChecks, nil).Times(1)
+				mock.iUnitOfWork.EXPECT().HealthCheckRequestRepository().Return(mock.iHealthCheckRequestRepository).Times(1)
+				mock.iHealthCheckRequestRepository.EXPECT().Stats(ctx, ids, from, to).Return(tableTest.stats, nil).Times(1)
+				mock.iUnitOfWork.EXPECT().IncidentRepository().Return(mock.iIncidentRepository).Times(1)
+				mock.iIncidentRepository.EXPECT().All(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(incidents, nil).Times(1)
+				mock.iHealthCheckRepository.EXPECT().All(ctx, gomock.Any()).Return(tableTest.healthChecks, nil).Times(1)
+			}
+
+			report, err := digestJobHandler.report(ctx, digest, from, to)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tableTest.want, report.String())
+		})
+	}
+}
+
+func TestDigestSend(t *testing.T) {
+	tableTests := []struct {
+		name      string
+		isClaimed bool
+	}{
+		{
+			name: "period claimed by another replica",
+		},
+		{
+			name:      "period claimed",
+			isClaimed: true,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			digestJobHandler := newDigestJobHandler(mock.iLogger, mock.iTracer, mock.iRedis, mock.iCron, mock.iUnitOfWork)
+			ctx := contextplus.Background()
+			digest := entities.NewDigest([16]byte{}, 1, "@daily", enums.DigestPeriodDaily, nil, nil)
+			digest.Id = 1
+
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+			mock.iSpan.EXPECT().Finish().Times(1)
+			mock.iRedis.EXPECT().SetNX(ctx, gomock.Cond(func(key any) bool {
+				return strings.HasPrefix(key.(string), "digest:1:")
+			}), true, enums.DigestPeriodDaily.Duration()).Return(tableTest.isClaimed, nil).Times(1)
+			if tableTest.isClaimed {
+				mock.iUnitOfWork.EXPECT().NotificationChannelRepository().Return(mock.iNotificationChannelRepository).Times(1)
+				mock.iNotificationChannelRepository.EXPECT().FirstOrDefault(ctx, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mock.iLogger.EXPECT().WithUint("digestId", uint(1)).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithUint("notificationChannelId", uint(1)).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().Warn(ctx, "digest notification channel not found").Times(1)
+			}
+
+			digestJobHandler.send(ctx, digest)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package jobs
+
+import (
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"time"
+)
+
+const (
+	_escalationCronKey = "escalation"
+	_escalationLease   = time.Minute
+)
+
+type SEscalationJobHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iCron       interfaces.ICron
+	iUnitOfWork interfaces.IUnitOfWork
+
+	pollInterval time.Duration
+	batchSize    uint
+
+	callEscalateDue func(ctx *contextplus.Context)
+	callEscalate    func(ctx *contextplus.Context, incident entities.Incident)
+}
+
+func newEscalationJobHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iCron interfaces.ICron,
+	iUnitOfWork interfaces.IUnitOfWork,
+	pollInterval time.Duration,
+	batchSize uint,
+) SEscalationJobHandler {
+	s := SEscalationJobHandler{
+		iLogger:      iLogger,
+		iTracer:      iTracer,
+		iCron:        iCron,
+		iUnitOfWork:  iUnitOfWork,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+	}
+	s.callEscalateDue = s.escalateDue
+	s.callEscalate = s.escalate
+	return s
+}
+
+func (r SEscalationJobHandler) Start(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := r.iCron.AddFunc(_escalationCronKey, fmt.Sprintf("@every %s", r.pollInterval), func() {
+		r.callEscalateDue(ctx)
+	}); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in add escalation job")
+
+		return err
+	}
+
+	return nil
+}
+
+func (r SEscalationJobHandler) Stop(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	r.iCron.RemoveFunc(_escalationCronKey)
+
+	return nil
+}
+
+func (r SEscalationJobHandler) escalateDue(ctx *contextplus.Context) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	incidents, err := r.iUnitOfWork.IncidentRepository().ClaimDueEscalations(ctx, r.batchSize, _escalationLease)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in claim due incidents")
+
+		return
+	}
+
+	for _, incident := range incidents {
+		r.callEscalate(ctx, incident)
+	}
+}
+
+func (r SEscalationJobHandler) escalate(ctx *contextplus.Context, incident entities.Incident) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	healthCheck, err := r.iUnitOfWork.HealthCheckRepository().FirstOrDefault(ctx, genericRepository.Equal("id", incident.HealthCheckId), genericRepository.Equal("tenant_id", incident.TenantId))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("incidentId", incident.Id).Error(ctx, "error in find incident health check")
+
+		return
+	}
+
+	var escalationPolicy *entities.EscalationPolicy
+	if healthCheck != nil && healthCheck.EscalationPolicyId != nil {
+		if escalationPolicy, err = r.iUnitOfWork.EscalationPolicyRepository().FirstOrDefault(ctx, genericRepository.Equal("id", *healthCheck.EscalationPolicyId), genericRepository.Equal("tenant_id", incident.TenantId)); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("incidentId", incident.Id).Error(ctx, "error in find escalation policy")
+
+			return
+		}
+	}
+
+	if escalationPolicy == nil {
+		incident.StopEscalation()
+		r.save(ctx, incident)
+		return
+	}
+
+	silence, err := r.iUnitOfWork.SilenceRepository().Active(ctx, incident.TenantId, incident.HealthCheckId, time.Now())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("incidentId", incident.Id).Error(ctx, "error in find active silence")
+
+		return
+	}
+
+	if silence != nil {
+		incident.PostponeEscalation(silence.EndsAt)
+		r.save(ctx, incident)
+		return
+	}
+
+	parentId, err := r.iUnitOfWork.HealthCheckDependencyRepository().DownAncestor(ctx, incident.HealthCheckId)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("incidentId", incident.Id).Error(ctx, "error in find down ancestor")
+
+		return
+	}
+
+	if parentId != nil || healthCheck.Flapping {
+		incident.PostponeEscalation(time.Now().Add(r.pollInterval))
+		r.save(ctx, incident)
+		return
+	}
+
+	step, next := escalationPolicy.Step(incident.EscalationStep, time.Now())
+	if step == nil {
+		incident.StopEscalation()
+		r.save(ctx, incident)
+		return
+	}
+
+	notificationChannel, err := r.iUnitOfWork.NotificationChannelRepository().FirstOrDefault(ctx, genericRepository.Equal("id", step.NotificationChannelId), genericRepository.Equal("tenant_id", incident.TenantId))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("incidentId", incident.Id).Error(ctx, "error in find escalation notification channel")
+
+		return
+	}
+
+	if notificationChannel == nil {
+		r.iLogger.WithUint("incidentId", incident.Id).WithUint("notificationChannelId", step.NotificationChannelId).Warn(ctx, "escalation notification channel not found")
+	}
+
+	message := withRunbook(*healthCheck, fmt.Sprintf("incident id : %d | escalation step : %d | opened at : %s", incident.Id, incident.EscalationStep+1, incident.CreatedAt.Format(time.RFC3339)))
+	incident.Escalate(step.NotificationChannelId, next)
+
+	// the step is recorded first so the notification is only queued when no acknowledgement or resolution won the race
+	var isUpdated bool
+	if err = r.iUnitOfWork.Do(ctx, func(iUnitOfWork interfaces.IUnitOfWork) error {
+		if isUpdated, err = iUnitOfWork.IncidentRepository().UpdateEscalation(ctx, &incident); err != nil || !isUpdated || notificationChannel == nil {
+			return err
+		}
+		return enqueueChannelNotification(ctx, iUnitOfWork, *notificationChannel, notificationSubject(*healthCheck), message)
+	}); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("incidentId", incident.Id).Error(ctx, "error in escalate incident")
+
+		return
+	}
+
+	if !isUpdated {
+		r.iLogger.WithUint("incidentId", incident.Id).Info(ctx, "incident was acknowledged or resolved before its escalation")
+	}
+}
+
+func (r SEscalationJobHandler) save(ctx *contextplus.Context, incident entities.Incident) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	isUpdated, err := r.iUnitOfWork.IncidentRepository().UpdateEscalation(ctx, &incident)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("incident", incident).Error(ctx, "error in save incident escalation")
+
+		return
+	}
+
+	if !isUpdated {
+		r.iLogger.WithUint("incidentId", incident.Id).Info(ctx, "incident was acknowledged or resolved before its escalation")
+	}
+}
@@ -0,0 +1,180 @@
+package jobs
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"testing"
+	"time"
+)
+
+func TestEscalate(t *testing.T) {
+	escalationPolicyId := uint(2)
+	parentId := uint(8)
+	escalationPolicy := entities.NewEscalationPolicy([16]byte{}, "on call", []valueObjects.EscalationStep{
+		{NotificationChannelId: 11, DelayMinute: 0},
+		{NotificationChannelId: 12, DelayMinute: 15},
+	})
+	silence := entities.NewSilence([16]byte{}, nil, nil, nil, "maintenance", [16]byte{}, time.Hour)
+
+	tableTests := []struct {
+		name               string
+		escalationPolicyId *uint
+		escalationStep     uint
+		silence            *entities.Silence
+		parentId           *uint
+		escalatedTo        uint
+		acknowledged       bool
+		assert             func(t *testing.T, incident entities.Incident)
+	}{
+		{
+			name:               "first step notifies its channel and schedules the next step",
+			escalationPolicyId: &escalationPolicyId,
+			escalatedTo:        11,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.Equal(t, uint(1), incident.EscalationStep)
+				assert.Equal(t, []uint{11}, incident.EscalatedChannelIds())
+				assert.NotNil(t, incident.NextEscalationAt)
+				assert.WithinDuration(t, time.Now().Add(15*time.Minute), *incident.NextEscalationAt, time.Minute)
+			},
+		},
+		{
+			name:               "incident acknowledged meanwhile is not notified",
+			escalationPolicyId: &escalationPolicyId,
+			escalatedTo:        11,
+			acknowledged:       true,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.Equal(t, uint(1), incident.EscalationStep)
+			},
+		},
+		{
+			name:               "last step notifies its channel and stops",
+			escalationPolicyId: &escalationPolicyId,
+			escalationStep:     1,
+			escalatedTo:        12,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.Equal(t, uint(2), incident.EscalationStep)
+				assert.Nil(t, incident.NextEscalationAt)
+			},
+		},
+		{
+			name:               "exhausted policy stops the escalation",
+			escalationPolicyId: &escalationPolicyId,
+			escalationStep:     2,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.Equal(t, uint(2), incident.EscalationStep)
+				assert.Nil(t, incident.NextEscalationAt)
+			},
+		},
+		{
+			name:               "active silence postpones the escalation to its end",
+			escalationPolicyId: &escalationPolicyId,
+			silence:            &silence,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.Equal(t, uint(0), incident.EscalationStep)
+				assert.Equal(t, silence.EndsAt, *incident.NextEscalationAt)
+			},
+		},
+		{
+			name:               "down parent postpones the escalation by the poll interval",
+			escalationPolicyId: &escalationPolicyId,
+			parentId:           &parentId,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.Equal(t, uint(0), incident.EscalationStep)
+				assert.WithinDuration(t, time.Now().Add(time.Minute), *incident.NextEscalationAt, time.Second)
+			},
+		},
+		{
+			name: "health check without a policy stops the escalation",
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.Nil(t, incident.NextEscalationAt)
+			},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			escalationJobHandler := newEscalationJobHandler(mock.iLogger, mock.iTracer, mock.iCron, mock.iUnitOfWork, time.Minute, 50)
+			ctx := contextplus.Background()
+			healthCheck := entities.HealthCheck{Id: 1, Name: "api", EscalationPolicyId: tableTest.escalationPolicyId}
+			incident := entities.NewIncident(healthCheck.TenantId, healthCheck.Id, true)
+			incident.Id = 5
+			incident.EscalationStep = tableTest.escalationStep
+
+			spans := 2
+			if tableTest.escalatedTo != 0 {
+				spans = 1
+			}
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(spans)
+			mock.iSpan.EXPECT().Finish().Times(spans)
+			mock.iUnitOfWork.EXPECT().HealthCheckRepository().Return(mock.iHealthCheckRepository).Times(1)
+			mock.iHealthCheckRepository.EXPECT().FirstOrDefault(ctx, gomock.Any(), gomock.Any()).Return(&healthCheck, nil).Times(1)
+			if tableTest.escalationPolicyId != nil {
+				mock.iUnitOfWork.EXPECT().EscalationPolicyRepository().Return(mock.iEscalationPolicyRepository).Times(1)
+				mock.iEscalationPolicyRepository.EXPECT().FirstOrDefault(ctx, gomock.Any(), gomock.Any()).Return(&escalationPolicy, nil).Times(1)
+				mock.iUnitOfWork.EXPECT().SilenceRepository().Return(mock.iSilenceRepository).Times(1)
+				mock.iSilenceRepository.EXPECT().Active(ctx, incident.TenantId, healthCheck.Id, gomock.Any()).Return(tableTest.silence, nil).Times(1)
+				if tableTest.silence == nil {
+					mock.iUnitOfWork.EXPECT().HealthCheckDependencyRepository().Return(mock.iHealthCheckDependencyRepository).Times(1)
+					mock.iHealthCheckDependencyRepository.EXPECT().DownAncestor(ctx, healthCheck.Id).Return(tableTest.parentId, nil).Times(1)
+				}
+			}
+			if tableTest.escalatedTo != 0 {
+				notificationChannel := entities.NewNotificationChannel(incident.TenantId, "pager", enums.NotificationProviderSlack, "#on-call", nil)
+				notificationChannel.Id = tableTest.escalatedTo
+				mock.iUnitOfWork.EXPECT().NotificationChannelRepository().Return(mock.iNotificationChannelRepository).Times(1)
+				mock.iNotificationChannelRepository.EXPECT().FirstOrDefault(ctx, gomock.Any(), gomock.Any()).Return(&notificationChannel, nil).Times(1)
+				mock.iUnitOfWork.EXPECT().Do(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, fn func(interfaces.IUnitOfWork) error) error {
+					return fn(mock.iUnitOfWork)
+				}).Times(1)
+				if tableTest.acknowledged {
+					mock.iLogger.EXPECT().WithUint("incidentId", incident.Id).Return(mock.iLogger).Times(1)
+					mock.iLogger.EXPECT().Info(ctx, "incident was acknowledged or resolved before its escalation").Times(1)
+				} else {
+					mock.iUnitOfWork.EXPECT().NotificationDeliveryRepository().Return(mock.iNotificationDeliveryRepository).Times(1)
+					mock.iNotificationDeliveryRepository.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, notificationDelivery *entities.NotificationDelivery) error {
+						assert.Equal(t, "#on-call", notificationDelivery.Receiver)
+						return nil
+					}).Times(1)
+				}
+			}
+
+			var saved entities.Incident
+			mock.iUnitOfWork.EXPECT().IncidentRepository().Return(mock.iIncidentRepository).Times(1)
+			mock.iIncidentRepository.EXPECT().UpdateEscalation(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, incident *entities.Incident) (bool, error) {
+				saved = *incident
+				return !tableTest.acknowledged, nil
+			}).Times(1)
+
+			escalationJobHandler.escalate(ctx, incident)
+
+			tableTest.assert(t, saved)
+		})
+	}
+}
+
+func TestEscalateDue(t *testing.T) {
+	mock := setup(t)
+	escalationJobHandler := newEscalationJobHandler(mock.iLogger, mock.iTracer, mock.iCron, mock.iUnitOfWork, time.Minute, 50)
+	ctx := contextplus.Background()
+	incidents := []entities.Incident{{Id: 1}, {Id: 2}}
+
+	mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+	mock.iSpan.EXPECT().Finish().Times(1)
+	mock.iUnitOfWork.EXPECT().IncidentRepository().Return(mock.iIncidentRepository).Times(1)
+	mock.iIncidentRepository.EXPECT().ClaimDueEscalations(ctx, uint(50), _escalationLease).Return(incidents, nil).Times(1)
+
+	var escalated []uint
+	escalationJobHandler.callEscalate = func(ctx *contextplus.Context, incident entities.Incident) {
+		escalated = append(escalated, incident.Id)
+	}
+
+	escalationJobHandler.escalateDue(ctx)
+
+	assert.Equal(t, []uint{1, 2}, escalated)
+}
@@ -0,0 +1,96 @@
+package jobs
+
+import (
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"slices"
+)
+
+// percentStateChange weighs state changes in chronologically ordered results from 0.8 for the oldest to 1.2 for
+// the newest, the way Nagios does, so recent instability counts more than instability about to leave the window.
+func percentStateChange(results []bool) float64 {
+	if len(results) < 2 {
+		return 0
+	}
+
+	var changes float64
+	for i := 1; i < len(results); i++ {
+		if results[i] != results[i-1] {
+			changes += 0.8 + 0.4*float64(i-1)/float64(max(len(results)-2, 1))
+		}
+	}
+
+	return changes * 100 / float64(len(results)-1)
+}
+
+func (r SHealthCheckJobHandler) detectFlapping(ctx *contextplus.Context, healthCheck entities.HealthCheck) bool {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	// the scheduled copy of the health check is stale, so the flag is read back from the database
+	current, err := r.iUnitOfWork.HealthCheckRepository().FirstOrDefault(ctx, genericRepository.Equal("id", healthCheck.Id))
+	if err != nil || current == nil {
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in find health check")
+		}
+
+		return healthCheck.Flapping
+	}
+
+	healthCheckRequests, err := r.iUnitOfWork.HealthCheckRequestRepository().Recent(ctx, healthCheck.Id, r.flappingWindowSize)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in get recent health check requests")
+
+		return current.Flapping
+	}
+
+	if uint(len(healthCheckRequests)) < r.flappingWindowSize {
+		return current.Flapping
+	}
+
+	results := make([]bool, 0, len(healthCheckRequests))
+	for _, healthCheckRequest := range healthCheckRequests {
+		results = append(results, healthCheckRequest.IsSuccess())
+	}
+	slices.Reverse(results)
+
+	percent := percentStateChange(results)
+	flapping := current.Flapping
+	switch {
+	case !flapping && percent >= r.flappingHighThreshold:
+		flapping = true
+	case flapping && percent < r.flappingLowThreshold:
+		flapping = false
+	default:
+		return flapping
+	}
+
+	if _, err = r.iUnitOfWork.HealthCheckRepository().UpdateColumn(ctx, "flapping", flapping, genericRepository.Equal("id", healthCheck.Id)); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in update health check flapping")
+
+		return current.Flapping
+	}
+
+	if !r.silenced(ctx, healthCheck) {
+		state := "stopped flapping"
+		if flapping {
+			state = "started flapping"
+		}
+		r.callSendNotification(
+			ctx,
+			healthCheck,
+			notificationSubject(healthCheck),
+			fmt.Sprintf("%s | percent state change : %.1f%%", state, percent),
+		)
+	}
+
+	return flapping
+}
@@ -0,0 +1,198 @@
+package jobs
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+	"health-check/application/handlers/commands"
+	"health-check/application/interfaces"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/tracer"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const _gitOpsCronKey = "gitOps"
+
+var (
+	errGitOpsTenantNotSet = errors.New("gitops tenant id is not set")
+	errGitOpsEmpty        = errors.New("gitops directory has no health check, refusing to prune every managed health check")
+)
+
+type IGitOpsJob interface {
+	IJob
+	Plan(ctx *contextplus.Context) ([]valueObjects.HealthCheckImportChange, error)
+}
+
+type SGitOpsJobHandler struct {
+	iLogger           logger.ILogger
+	iTracer           tracer.ITracer
+	iCron             interfaces.ICron
+	healthCheckImport commands.ICommand[commands.SHealthCheckImportCommand, []valueObjects.HealthCheckImportChange]
+
+	isEnabled       bool
+	directory       string
+	tenantId        uuid.UUID
+	pruneMode       enums.PruneMode
+	allowEmptyPrune bool
+	pollInterval    time.Duration
+	running         *sync.Mutex
+}
+
+func newGitOpsJobHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iCron interfaces.ICron,
+	healthCheckImport commands.ICommand[commands.SHealthCheckImportCommand, []valueObjects.HealthCheckImportChange],
+	isEnabled bool,
+	directory string,
+	tenantId uuid.UUID,
+	pruneMode enums.PruneMode,
+	allowEmptyPrune bool,
+	pollInterval time.Duration,
+) SGitOpsJobHandler {
+	return SGitOpsJobHandler{
+		iLogger:           iLogger,
+		iTracer:           iTracer,
+		iCron:             iCron,
+		healthCheckImport: healthCheckImport,
+		isEnabled:         isEnabled,
+		directory:         directory,
+		tenantId:          tenantId,
+		pruneMode:         pruneMode,
+		allowEmptyPrune:   allowEmptyPrune,
+		pollInterval:      pollInterval,
+		running:           new(sync.Mutex),
+	}
+}
+
+func (r SGitOpsJobHandler) Start(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if !r.isEnabled {
+		return nil
+	}
+
+	if r.tenantId == uuid.Nil {
+		span.SetTag("error", true)
+		span.LogKV("err", errGitOpsTenantNotSet)
+		r.iLogger.WithError(errGitOpsTenantNotSet).Error(ctx, "error in start gitops job")
+
+		return errGitOpsTenantNotSet
+	}
+
+	r.sync(ctx)
+
+	if err := r.iCron.AddFunc(_gitOpsCronKey, fmt.Sprintf("@every %s", r.pollInterval), func() {
+		r.sync(ctx)
+	}); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in add gitops job")
+
+		return err
+	}
+
+	return nil
+}
+
+func (r SGitOpsJobHandler) Stop(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if r.isEnabled {
+		r.iCron.RemoveFunc(_gitOpsCronKey)
+	}
+
+	return nil
+}
+
+// Plan returns the changes a sync would make without applying them.
+func (r SGitOpsJobHandler) Plan(ctx *contextplus.Context) ([]valueObjects.HealthCheckImportChange, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	return r.apply(ctx, true)
+}
+
+func (r SGitOpsJobHandler) sync(ctx *contextplus.Context) {
+	if !r.running.TryLock() {
+		return
+	}
+	defer r.running.Unlock()
+
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	changes, err := r.apply(ctx, false)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithString("directory", r.directory).Error(ctx, "error in sync gitops health checks")
+
+		return
+	}
+
+	for _, change := range changes {
+		if change.Action != enums.ImportActionUnchanged {
+			r.iLogger.WithAny("change", change).Info(ctx, "gitops health check synced")
+		}
+	}
+}
+
+func (r SGitOpsJobHandler) apply(ctx *contextplus.Context, dryRun bool) ([]valueObjects.HealthCheckImportChange, error) {
+	definitions, err := r.definitions()
+	if err != nil {
+		return nil, err
+	}
+
+	// an emptied or wrongly mounted directory reads as zero definitions, which would prune every managed health check
+	if len(definitions) == 0 && !r.allowEmptyPrune {
+		return nil, errGitOpsEmpty
+	}
+
+	return r.healthCheckImport.Handle(ctx, commands.NewHealthCheckImportCommand(r.tenantId, dryRun, true, r.pruneMode, definitions))
+}
+
+// definitions reads every yaml file of the directory in name order, an invalid file fails the whole sync so a
+// broken commit never prunes the checks it failed to describe.
+func (r SGitOpsJobHandler) definitions() ([]valueObjects.HealthCheckDefinition, error) {
+	entries, err := os.ReadDir(r.directory)
+	if err != nil {
+		return nil, err
+	}
+
+	var definitions []valueObjects.HealthCheckDefinition
+	for _, entry := range entries {
+		if entry.IsDir() || (filepath.Ext(entry.Name()) != ".yaml" && filepath.Ext(entry.Name()) != ".yml") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(r.directory, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var document valueObjects.HealthCheckDocument
+		if err = yaml.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		for i, definition := range document.HealthChecks {
+			if !definition.IsValid() {
+				return nil, fmt.Errorf("%s: health check %d is not valid", entry.Name(), i)
+			}
+		}
+
+		definitions = append(definitions, document.HealthChecks...)
+	}
+
+	return definitions, nil
+}
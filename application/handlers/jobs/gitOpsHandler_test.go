@@ -0,0 +1,153 @@
+package jobs
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"health-check/application/handlers/commands"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type healthCheckImportFunc func(ctx *contextplus.Context, command commands.SHealthCheckImportCommand) ([]valueObjects.HealthCheckImportChange, error)
+
+func (r healthCheckImportFunc) Handle(ctx *contextplus.Context, command commands.SHealthCheckImportCommand) ([]valueObjects.HealthCheckImportChange, error) {
+	return r(ctx, command)
+}
+
+func writeGitOpsFiles(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(directory, "nested.yaml"), 0o755))
+	for name, content := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(directory, name), []byte(content), 0o644))
+	}
+	return directory
+}
+
+func TestGitOpsPlan(t *testing.T) {
+	tenantId := uuid.New()
+	definition := func(name string) valueObjects.HealthCheckDefinition {
+		return valueObjects.HealthCheckDefinition{Name: name, Owner: "platform", Interval: "@every 1m", Url: "https://" + name + ".example.com", Method: enums.HttpMethodGET}
+	}
+	document := func(names ...string) string {
+		content := "healthChecks:\n"
+		for _, name := range names {
+			content += "  - name: " + name + "\n    owner: platform\n    interval: \"@every 1m\"\n    url: https://" + name + ".example.com\n    method: GET\n"
+		}
+		return content
+	}
+
+	tableTests := []struct {
+		name            string
+		files           map[string]string
+		definitions     []valueObjects.HealthCheckDefinition
+		allowEmptyPrune bool
+		err             string
+	}{
+		{
+			name: "definitions in file name order",
+			files: map[string]string{
+				"b.yml":     document("web"),
+				"a.yaml":    document("api", "worker"),
+				"README.md": "# health checks",
+			},
+			definitions: []valueObjects.HealthCheckDefinition{definition("api"), definition("worker"), definition("web")},
+		},
+		{
+			name: "empty directory refuses to prune",
+			files: map[string]string{
+				"README.md": "# health checks",
+			},
+			err: errGitOpsEmpty.Error(),
+		},
+		{
+			name:            "empty directory prunes when allowed",
+			allowEmptyPrune: true,
+		},
+		{
+			name: "invalid definition fails the plan",
+			files: map[string]string{
+				"a.yaml": document("api"),
+				"b.yaml": "healthChecks:\n  - name: web\n",
+			},
+			err: "b.yaml: health check 0 is not valid",
+		},
+		{
+			name: "malformed file fails the plan",
+			files: map[string]string{
+				"a.yaml": "healthChecks: [",
+			},
+			err: "a.yaml: yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			ctx := contextplus.Background()
+
+			var received *commands.SHealthCheckImportCommand
+			gitOpsJobHandler := newGitOpsJobHandler(mock.iLogger, mock.iTracer, mock.iCron, healthCheckImportFunc(func(ctx *contextplus.Context, command commands.SHealthCheckImportCommand) ([]valueObjects.HealthCheckImportChange, error) {
+				received = &command
+				return []valueObjects.HealthCheckImportChange{{Owner: "platform", Name: "api", Action: enums.ImportActionCreate}}, nil
+			}), true, writeGitOpsFiles(t, tableTest.files), tenantId, enums.PruneModeStop, tableTest.allowEmptyPrune, time.Minute)
+
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+			mock.iSpan.EXPECT().Finish().Times(1)
+
+			changes, err := gitOpsJobHandler.Plan(ctx)
+
+			if tableTest.err != "" {
+				assert.EqualError(t, err, tableTest.err)
+				assert.Nil(t, received)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, changes, 1)
+			assert.Equal(t, commands.NewHealthCheckImportCommand(tenantId, true, true, enums.PruneModeStop, tableTest.definitions), *received)
+		})
+	}
+}
+
+func TestGitOpsSync(t *testing.T) {
+	tenantId := uuid.New()
+	mock := setup(t)
+	ctx := contextplus.Background()
+	created := valueObjects.HealthCheckImportChange{Id: 1, Owner: "platform", Name: "api", Action: enums.ImportActionCreate}
+
+	var received *commands.SHealthCheckImportCommand
+	gitOpsJobHandler := newGitOpsJobHandler(mock.iLogger, mock.iTracer, mock.iCron, healthCheckImportFunc(func(ctx *contextplus.Context, command commands.SHealthCheckImportCommand) ([]valueObjects.HealthCheckImportChange, error) {
+		received = &command
+		return []valueObjects.HealthCheckImportChange{created, {Id: 2, Owner: "platform", Name: "web", Action: enums.ImportActionUnchanged}}, nil
+	}), true, writeGitOpsFiles(t, map[string]string{"a.yaml": "healthChecks:\n  - name: api\n    owner: platform\n    interval: \"@every 1m\"\n    url: https://api.example.com\n    method: GET\n"}), tenantId, enums.PruneModeDelete, false, time.Minute)
+
+	mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+	mock.iSpan.EXPECT().Finish().Times(1)
+	mock.iLogger.EXPECT().WithAny("change", created).Return(mock.iLogger).Times(1)
+	mock.iLogger.EXPECT().Info(ctx, "gitops health check synced").Times(1)
+
+	gitOpsJobHandler.sync(ctx)
+
+	assert.Equal(t, commands.NewHealthCheckImportCommand(tenantId, false, true, enums.PruneModeDelete, []valueObjects.HealthCheckDefinition{
+		{Name: "api", Owner: "platform", Interval: "@every 1m", Url: "https://api.example.com", Method: enums.HttpMethodGET},
+	}), *received)
+}
+
+func TestGitOpsStartWithoutTenant(t *testing.T) {
+	mock := setup(t)
+	ctx := contextplus.Background()
+	gitOpsJobHandler := newGitOpsJobHandler(mock.iLogger, mock.iTracer, mock.iCron, nil, true, t.TempDir(), uuid.Nil, enums.PruneModeStop, false, time.Minute)
+
+	mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+	mock.iSpan.EXPECT().SetTag("error", true).Times(1)
+	mock.iSpan.EXPECT().LogKV("err", errGitOpsTenantNotSet).Times(1)
+	mock.iSpan.EXPECT().Finish().Times(1)
+	mock.iLogger.EXPECT().WithError(errGitOpsTenantNotSet).Return(mock.iLogger).Times(1)
+	mock.iLogger.EXPECT().Error(ctx, "error in start gitops job").Times(1)
+
+	assert.Equal(t, errGitOpsTenantNotSet, gitOpsJobHandler.Start(ctx))
+}
@@ -2,32 +2,61 @@ package jobs
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-logger"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"health-check/application/interfaces"
 	"health-check/domain/entities"
 	"health-check/domain/enums"
 	"health-check/pkg/genericRepository"
 	"health-check/pkg/tracer"
-	"net/http"
+	"sync/atomic"
+	"time"
 )
 
+const (
+	datastoreStatusOk         = "OK"
+	datastoreStatusError      = "ERROR"
+	datastoreStatusUnexpected = "UNEXPECTED_RESULT"
+
+	templateStatusError = "TEMPLATE_ERROR"
+)
+
+var errUnexpectedResult = errors.New("unexpected result")
+
+type IHealthCheckJob interface {
+	IJob
+	Ping() error
+}
+
 type SHealthCheckJobHandler struct {
-	iLogger       logger.ILogger
-	iTracer       tracer.ITracer
-	iRedis        interfaces.IRedis
-	iCron         interfaces.ICron
-	iRest         interfaces.IRest
-	iNotification interfaces.INotification
-	iUnitOfWork   interfaces.IUnitOfWork
+	iLogger        logger.ILogger
+	iTracer        tracer.ITracer
+	iRedis         interfaces.IRedis
+	iCron          interfaces.ICron
+	iRest          interfaces.IRest
+	iGrpcProbe     interfaces.IGrpcProbe
+	iDnsProbe      interfaces.IDnsProbe
+	iPostgresProbe interfaces.IPostgresProbe
+	iRedisProbe    interfaces.IRedisProbe
+	iCipher        interfaces.ICipher
+	iNotification  interfaces.INotification
+	iUnitOfWork    interfaces.IUnitOfWork
+
+	flappingWindowSize    uint
+	flappingLowThreshold  float64
+	flappingHighThreshold float64
+	templateEnvPrefix     string
 
 	callAddJob           func(ctx *contextplus.Context, healthCheck entities.HealthCheck)
 	callSubRedis         func(ctx *contextplus.Context)
 	callSendRequest      func(ctx *contextplus.Context, healthCheck entities.HealthCheck)
-	callSendNotification func(ctx *contextplus.Context, subject string, msg string)
+	callSendNotification func(ctx *contextplus.Context, healthCheck entities.HealthCheck, subject string, msg string)
 
 	healthCheckChannel chan string
+	subscribed         *atomic.Bool
 }
 
 func newHealthCheckJobHandler(
@@ -36,18 +65,37 @@ func newHealthCheckJobHandler(
 	iRedis interfaces.IRedis,
 	iCron interfaces.ICron,
 	iRest interfaces.IRest,
+	iGrpcProbe interfaces.IGrpcProbe,
+	iDnsProbe interfaces.IDnsProbe,
+	iPostgresProbe interfaces.IPostgresProbe,
+	iRedisProbe interfaces.IRedisProbe,
+	iCipher interfaces.ICipher,
 	iNotification interfaces.INotification,
 	iUnitOfWork interfaces.IUnitOfWork,
+	flappingWindowSize uint,
+	flappingLowThreshold float64,
+	flappingHighThreshold float64,
+	templateEnvPrefix string,
 ) SHealthCheckJobHandler {
 	s := SHealthCheckJobHandler{
-		iLogger:            iLogger,
-		iTracer:            iTracer,
-		iRedis:             iRedis,
-		iCron:              iCron,
-		iRest:              iRest,
-		iNotification:      iNotification,
-		iUnitOfWork:        iUnitOfWork,
-		healthCheckChannel: make(chan string),
+		iLogger:               iLogger,
+		iTracer:               iTracer,
+		iRedis:                iRedis,
+		iCron:                 iCron,
+		iRest:                 iRest,
+		iGrpcProbe:            iGrpcProbe,
+		iDnsProbe:             iDnsProbe,
+		iPostgresProbe:        iPostgresProbe,
+		iRedisProbe:           iRedisProbe,
+		iCipher:               iCipher,
+		iNotification:         iNotification,
+		iUnitOfWork:           iUnitOfWork,
+		flappingWindowSize:    flappingWindowSize,
+		flappingLowThreshold:  flappingLowThreshold,
+		flappingHighThreshold: flappingHighThreshold,
+		templateEnvPrefix:     templateEnvPrefix,
+		healthCheckChannel:    make(chan string),
+		subscribed:            new(atomic.Bool),
 	}
 	s.callAddJob = s.addJob
 	s.callSubRedis = s.subRedis
@@ -89,6 +137,14 @@ func (r SHealthCheckJobHandler) Stop(ctx *contextplus.Context) error {
 	return nil
 }
 
+// Ping reports whether the loop receiving health check changes from redis is running.
+func (r SHealthCheckJobHandler) Ping() error {
+	if !r.subscribed.Load() {
+		return errors.New("redis subscription is not running")
+	}
+	return nil
+}
+
 func (r SHealthCheckJobHandler) addJob(ctx *contextplus.Context, healthCheck entities.HealthCheck) {
 	span, ctx := r.iTracer.SpanFromContext(ctx)
 	defer span.Finish()
@@ -98,7 +154,12 @@ func (r SHealthCheckJobHandler) addJob(ctx *contextplus.Context, healthCheck ent
 		return
 	}
 
-	if err := r.iCron.AddJob(healthCheck.Id, healthCheck.UpdatedAt, healthCheck.Interval, func() {
+	interval := healthCheck.Interval
+	if healthCheck.Type == enums.ProbeTypeHeartbeat {
+		interval = heartbeatTimerInterval(healthCheck.Interval)
+	}
+
+	if err := r.iCron.AddJob(healthCheck.Id, healthCheck.UpdatedAt, interval, func() {
 		r.callSendRequest(ctx, healthCheck)
 	}); err != nil {
 		span.SetTag("error", true)
@@ -113,7 +174,13 @@ func (r SHealthCheckJobHandler) subRedis(ctx *contextplus.Context) {
 	span, ctx := r.iTracer.SpanFromContext(ctx)
 	defer span.Finish()
 
-	go r.iRedis.Subscribe(ctx, "healthCheck", r.healthCheckChannel)
+	r.subscribed.Store(true)
+	defer r.subscribed.Store(false)
+
+	go func() {
+		r.iRedis.Subscribe(ctx, "healthCheck", r.healthCheckChannel)
+		r.subscribed.Store(false)
+	}()
 
 	var healthCheck entities.HealthCheck
 
@@ -134,51 +201,516 @@ func (r SHealthCheckJobHandler) sendRequest(ctx *contextplus.Context, healthChec
 	span, ctx := r.iTracer.SpanFromContext(ctx)
 	defer span.Finish()
 
-	statusCode, header, body, err := r.iRest.Execute(ctx, healthCheck.Method, healthCheck.Url, healthCheck.Headers.Data(), healthCheck.Body.Data())
+	if healthCheck.Type == enums.ProbeTypeHeartbeat {
+		r.checkHeartbeat(ctx, healthCheck)
+		return
+	}
+
+	healthCheckRequest, ok := r.probe(ctx, healthCheck)
+	if !ok {
+		return
+	}
+
+	if !healthCheckRequest.IsSuccess() {
+		parentId, err := r.iUnitOfWork.HealthCheckDependencyRepository().DownAncestor(ctx, healthCheck.Id)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in find down ancestor")
+		}
+
+		if parentId != nil {
+			healthCheckRequest.SetUnreachable(*parentId)
+		}
+	}
+
+	if err := r.iUnitOfWork.HealthCheckRequestRepository().Create(ctx, &healthCheckRequest); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("healthCheckRequest", healthCheckRequest).Error(ctx, "error in create health check request")
+
+		return
+	}
+
+	flapping := r.detectFlapping(ctx, healthCheck)
+
+	r.handleIncident(ctx, healthCheck, healthCheckRequest, flapping)
+}
+
+// probe runs the check matching the type of the health check, ok is false when there is no result worth recording.
+func (r SHealthCheckJobHandler) probe(ctx *contextplus.Context, healthCheck entities.HealthCheck) (entities.HealthCheckRequest, bool) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	startedAt := time.Now()
+	switch healthCheck.Type {
+	case enums.ProbeTypeGrpc:
+		status, header, err := r.iGrpcProbe.Check(ctx, healthCheck.Url, healthCheck.Settings.Data().GrpcOrDefault())
+		duration := time.Since(startedAt)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in check grpc health")
+
+			return entities.NewHealthCheckProbeRequest(healthCheck.Id, header, err.Error(), status, false, duration), true
+		}
+
+		return entities.NewHealthCheckProbeRequest(healthCheck.Id, header, "", status, status == grpc_health_v1.HealthCheckResponse_SERVING.String(), duration), true
+	case enums.ProbeTypeDns:
+		settings := healthCheck.Settings.Data().DnsOrDefault()
+		status, records, err := r.iDnsProbe.Lookup(ctx, healthCheck.Url, settings)
+		duration := time.Since(startedAt)
+		if err == nil {
+			err = settings.Assert(records)
+		}
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in check dns records")
+		}
+
+		return entities.NewHealthCheckProbeRequest(healthCheck.Id, nil, dnsProbeBody(records, err), status, err == nil, duration), true
+	case enums.ProbeTypePostgres, enums.ProbeTypeRedis:
+		result, connectDuration, queryDuration, err := r.queryDatastore(ctx, healthCheck)
+		duration := time.Since(startedAt)
+		status := datastoreStatusOk
+		if err != nil {
+			status = datastoreStatusError
+			if errors.Is(err, errUnexpectedResult) {
+				status = datastoreStatusUnexpected
+			}
+
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in check datastore")
+		}
+
+		return entities.NewHealthCheckProbeRequest(healthCheck.Id, nil, datastoreProbeBody(result, connectDuration, queryDuration, err), status, err == nil, duration), true
+	case enums.ProbeTypeMultiStep:
+		requestTemplate, err := r.requestTemplate(ctx, healthCheck)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in open health check secrets")
+
+			return entities.HealthCheckRequest{}, false
+		}
+
+		results, header, err := r.runSteps(ctx, healthCheck, requestTemplate)
+		duration := time.Since(startedAt)
+		status := multiStepStatusOk
+		if err != nil {
+			status = multiStepStatusFailed
+			err = requestTemplate.mask(err)
+
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in run multi step health check")
+		}
+
+		healthCheckRequest := entities.NewHealthCheckProbeRequest(healthCheck.Id, header, multiStepProbeBody(results, err), status, err == nil, duration)
+		healthCheckRequest.Mask(requestTemplate.sensitive)
+		return healthCheckRequest, true
+	default:
+		requestTemplate, err := r.requestTemplate(ctx, healthCheck)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in open health check secrets")
+
+			return entities.HealthCheckRequest{}, false
+		}
+		requestTemplate.literal = !healthCheck.Settings.Data().Templates
+
+		url, headers, body, err := requestTemplate.request(healthCheck.Url, healthCheck.Headers.Data(), healthCheck.Body.Data())
+		if err != nil {
+			err = requestTemplate.mask(err)
+
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in render request templates")
+
+			return entities.NewHealthCheckProbeRequest(healthCheck.Id, nil, err.Error(), templateStatusError, false, time.Since(startedAt)), true
+		}
+
+		statusCode, header, responseBody, err := r.iRest.Execute(ctx, healthCheck.Method, url, headers, body, requestTemplate.sensitive)
+		duration := time.Since(startedAt)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).Error(ctx, "error in execute rest request")
+
+			return entities.HealthCheckRequest{}, false
+		}
+
+		healthCheckRequest := entities.NewHealthCheckRequest(healthCheck.Id, header, responseBody, statusCode, duration)
+		healthCheckRequest.Mask(requestTemplate.sensitive)
+		return healthCheckRequest, true
+	}
+}
+
+// requestTemplate opens the sealed secrets of an http or multi step health check for the templates of its requests, the
+// secrets it does not define are read from the secret store of its tenant and only ever opened in memory.
+func (r SHealthCheckJobHandler) requestTemplate(ctx *contextplus.Context, healthCheck entities.HealthCheck) (*sTemplate, error) {
+	settings, err := healthCheck.Settings.Data().Open(healthCheck.TenantId, r.iCipher.Decrypt)
+	if err != nil {
+		return nil, err
+	}
+	return newTemplate(r.templateEnvPrefix, settings.Secrets, func(name string) (string, bool, error) {
+		secret, err := r.iUnitOfWork.SecretRepository().SingleOrDefault(
+			ctx,
+			genericRepository.Equal("tenant_id", healthCheck.TenantId),
+			genericRepository.Equal("name", name),
+		)
+		if err != nil || secret == nil {
+			return "", false, err
+		}
+		value, err := r.iCipher.Decrypt(secret.TenantId, entities.SecretField(secret.Name), secret.Value)
+		return value, err == nil, err
+	}), nil
+}
+
+// checkHeartbeat is the timer of a heartbeat check, it records a missed request once the deadline passes without a
+// ping and otherwise lets the last ping open or resolve the incident.
+func (r SHealthCheckJobHandler) checkHeartbeat(ctx *contextplus.Context, healthCheck entities.HealthCheck) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	healthCheckRequests, err := r.iUnitOfWork.HealthCheckRequestRepository().Recent(ctx, healthCheck.Id, 1)
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("err", err)
-		r.iLogger.WithError(err).Error(ctx, "error in execute rest request")
+		r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in get last heartbeat ping")
 
 		return
 	}
 
-	healthCheckRequest := entities.NewHealthCheckRequest(
-		healthCheck.Id,
-		header,
-		body,
-		statusCode,
+	var last *entities.HealthCheckRequest
+	if len(healthCheckRequests) != 0 {
+		last = &healthCheckRequests[0]
+	}
+
+	healthCheckRequest, isMissed, err := heartbeatState(healthCheck, last, time.Now())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in find heartbeat deadline")
+
+		return
+	}
+
+	if healthCheckRequest == nil {
+		return
+	}
+
+	if isMissed {
+		if err = r.iUnitOfWork.HealthCheckRequestRepository().Create(ctx, healthCheckRequest); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("healthCheckRequest", healthCheckRequest).Error(ctx, "error in create missed heartbeat request")
+
+			return
+		}
+	}
+
+	flapping := r.detectFlapping(ctx, healthCheck)
+
+	r.handleIncident(ctx, healthCheck, *healthCheckRequest, flapping)
+}
+
+// heartbeatState returns the request deciding whether a heartbeat check is up, isMissed is true when it is a new missed
+// request to record. No request is returned while the check waits for its first ping or for a started run to finish.
+func heartbeatState(healthCheck entities.HealthCheck, last *entities.HealthCheckRequest, now time.Time) (healthCheckRequest *entities.HealthCheckRequest, isMissed bool, err error) {
+	if last != nil && last.Status == entities.HealthCheckRequestStatusMissed {
+		return last, false, nil
+	}
+	if last != nil && !last.IsHeartbeatPing() {
+		last = nil
+	}
+
+	var lastPingAt *time.Time
+	if last != nil {
+		lastPingAt = &last.CreatedAt
+	}
+
+	deadline, err := healthCheck.HeartbeatDeadline(lastPingAt)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if now.After(deadline) {
+		missed := entities.NewHealthCheckMissedRequest(healthCheck.Id, deadline)
+		return &missed, true, nil
+	}
+
+	if last == nil || last.Status == enums.HeartbeatSignalStart.String() {
+		return nil, false, nil
+	}
+
+	return last, false, nil
+}
+
+// heartbeatTimerInterval checks a heartbeat at least every minute so a long period does not delay noticing a missed
+// deadline or a failed ping by as much as the period itself.
+func heartbeatTimerInterval(interval string) string {
+	period, err := time.ParseDuration(interval)
+	if err != nil || period <= time.Minute {
+		return interval
+	}
+	return time.Minute.String()
+}
+
+// queryDatastore opens the sealed credentials of a postgres or redis health check, runs its query and asserts the
+// expected result.
+func (r SHealthCheckJobHandler) queryDatastore(ctx *contextplus.Context, healthCheck entities.HealthCheck) (result string, connectDuration time.Duration, queryDuration time.Duration, err error) {
+	settings, err := healthCheck.Settings.Data().Open(healthCheck.TenantId, r.iCipher.Decrypt)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	var expected string
+	if healthCheck.Type == enums.ProbeTypePostgres {
+		postgresSettings := settings.PostgresOrDefault()
+		expected = postgresSettings.Expected
+		result, connectDuration, queryDuration, err = r.iPostgresProbe.Query(ctx, healthCheck.Url, postgresSettings)
+	} else {
+		redisSettings := settings.RedisOrDefault()
+		expected = redisSettings.Expected
+		result, connectDuration, queryDuration, err = r.iRedisProbe.Command(ctx, healthCheck.Url, redisSettings)
+	}
+
+	if err == nil && expected != "" && result != expected {
+		err = fmt.Errorf("%w: got %q, expected %q", errUnexpectedResult, result, expected)
+	}
+
+	return result, connectDuration, queryDuration, err
+}
+
+// datastoreProbeBody stores the result of a postgres or redis probe with the connect and query latency in milliseconds.
+func datastoreProbeBody(result string, connectDuration time.Duration, queryDuration time.Duration, err error) string {
+	body := struct {
+		Result          string `json:"result"`
+		ConnectDuration int64  `json:"connectDuration"`
+		QueryDuration   int64  `json:"queryDuration"`
+		Error           string `json:"error,omitempty"`
+	}{Result: result, ConnectDuration: connectDuration.Milliseconds(), QueryDuration: queryDuration.Milliseconds()}
+	if err != nil {
+		body.Error = err.Error()
+	}
+	data, _ := json.Marshal(body)
+	return string(data)
+}
+
+// dnsProbeBody stores the records a dns probe got back along with the reason it failed, if it did.
+func dnsProbeBody(records []string, err error) string {
+	body := struct {
+		Records []string `json:"records"`
+		Error   string   `json:"error,omitempty"`
+	}{Records: records}
+	if err != nil {
+		body.Error = err.Error()
+	}
+	data, _ := json.Marshal(body)
+	return string(data)
+}
+
+func (r SHealthCheckJobHandler) handleIncident(ctx *contextplus.Context, healthCheck entities.HealthCheck, healthCheckRequest entities.HealthCheckRequest, flapping bool) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	incident, err := r.iUnitOfWork.IncidentRepository().LastOrDefault(
+		ctx,
+		genericRepository.Equal("health_check_id", healthCheck.Id),
+		genericRepository.NotEqual("status", enums.IncidentStatusResolved),
 	)
-	if err = r.iUnitOfWork.HealthCheckRequestRepository().Create(ctx, &healthCheckRequest); err != nil {
+	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("err", err)
-		r.iLogger.WithError(err).WithAny("healthCheckRequest", healthCheckRequest).Error(ctx, "error in create health check request")
+		r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in find open incident")
 
 		return
 	}
 
-	if statusCode != http.StatusOK {
-		r.callSendNotification(
-			ctx,
-			fmt.Sprintf("id : %d | url : %s | method : %s", healthCheck.Id, healthCheck.Url, healthCheck.Method),
-			fmt.Sprintf("request id : %d | status code : %d | response body : %s", healthCheckRequest.Id, statusCode, body),
-		)
+	isSuccess := healthCheckRequest.IsSuccess()
+
+	if !isSuccess && incident == nil {
+		newIncident := entities.NewIncident(healthCheck.TenantId, healthCheck.Id, healthCheck.EscalationPolicyId != nil && !flapping)
+		if healthCheckRequest.UnreachableParentId != nil {
+			newIncident.MarkUnreachable(*healthCheckRequest.UnreachableParentId)
+		}
+		if flapping {
+			newIncident.Suppress()
+		}
+		if err = r.iUnitOfWork.IncidentRepository().Create(ctx, &newIncident); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("incident", newIncident).Error(ctx, "error in create incident")
+
+			return
+		}
+
+		if newIncident.UnreachableParentId == nil {
+			r.notifyOpened(ctx, healthCheck, newIncident, healthCheckRequest, flapping)
+		}
+		return
+	}
+
+	if !isSuccess && incident.UnreachableParentId != nil && healthCheckRequest.UnreachableParentId == nil {
+		incident.MarkReachable(healthCheck.EscalationPolicyId != nil && !flapping)
+		if flapping {
+			incident.Suppress()
+		}
+		isUpdated, err := r.iUnitOfWork.IncidentRepository().UpdateSuppression(ctx, incident)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("incident", incident).Error(ctx, "error in mark incident reachable")
+
+			return
+		}
+
+		if !isUpdated {
+			return
+		}
+
+		r.notifyOpened(ctx, healthCheck, *incident, healthCheckRequest, flapping)
+		return
+	}
+
+	// the incident opened while flapping is announced and escalated once the flapping clears with the check still down
+	if !isSuccess && incident.Suppressed && !flapping && incident.UnreachableParentId == nil && incident.Status == enums.IncidentStatusOpen {
+		incident.Unsuppress(healthCheck.EscalationPolicyId != nil)
+		isUpdated, err := r.iUnitOfWork.IncidentRepository().UpdateSuppression(ctx, incident)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("incident", incident).Error(ctx, "error in unsuppress incident")
+
+			return
+		}
+
+		if !isUpdated {
+			return
+		}
+
+		r.notifyOpened(ctx, healthCheck, *incident, healthCheckRequest, flapping)
+		return
+	}
+
+	if isSuccess && incident != nil {
+		incident.Resolve()
+		isResolved, err := r.iUnitOfWork.IncidentRepository().Resolve(ctx, incident)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithAny("incident", incident).Error(ctx, "error in resolve incident")
+
+			return
+		}
+
+		if isResolved && incident.UnreachableParentId == nil && !flapping && !incident.Suppressed {
+			r.notifyResolved(ctx, healthCheck, *incident, healthCheckRequest)
+		}
 	}
 }
 
-func (r SHealthCheckJobHandler) sendNotification(ctx *contextplus.Context, subject string, msg string) {
+func (r SHealthCheckJobHandler) notifyOpened(ctx *contextplus.Context, healthCheck entities.HealthCheck, incident entities.Incident, healthCheckRequest entities.HealthCheckRequest, flapping bool) {
 	span, ctx := r.iTracer.SpanFromContext(ctx)
 	defer span.Finish()
 
-	if err := r.iNotification.Send(
+	if healthCheck.EscalationPolicyId != nil || flapping || r.silenced(ctx, healthCheck) {
+		return
+	}
+
+	r.callSendNotification(
 		ctx,
-		subject,
-		msg,
-	); err != nil {
+		healthCheck,
+		notificationSubject(healthCheck),
+		withRunbook(healthCheck, fmt.Sprintf("incident id : %d | request id : %d | status code : %d | response body : %s", incident.Id, healthCheckRequest.Id, healthCheckRequest.StatusCode, healthCheckRequest.Body)),
+	)
+}
+
+func (r SHealthCheckJobHandler) notifyResolved(ctx *contextplus.Context, healthCheck entities.HealthCheck, incident entities.Incident, healthCheckRequest entities.HealthCheckRequest) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if r.silenced(ctx, healthCheck) {
+		return
+	}
+
+	subject := notificationSubject(healthCheck)
+	msg := fmt.Sprintf("incident id : %d | resolved | request id : %d | status code : %d", incident.Id, healthCheckRequest.Id, healthCheckRequest.StatusCode)
+
+	channelIds := incident.EscalatedChannelIds()
+	if len(channelIds) == 0 {
+		r.callSendNotification(ctx, healthCheck, subject, msg)
+		return
+	}
+
+	notificationChannels, err := r.iUnitOfWork.NotificationChannelRepository().All(ctx, genericRepository.In("id", channelIds...), genericRepository.Equal("tenant_id", healthCheck.TenantId))
+	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("err", err)
-		r.iLogger.WithError(err).WithString("subject", subject).WithString("msg", msg).Error(ctx, "error in send health check notification")
+		r.iLogger.WithError(err).WithUints("notificationChannelIds", channelIds).Error(ctx, "error in get escalated notification channels")
 
 		return
 	}
+
+	for _, notificationChannel := range notificationChannels {
+		if err = enqueueChannelNotification(ctx, r.iUnitOfWork, notificationChannel, subject, msg); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("notificationChannelId", notificationChannel.Id).Error(ctx, "error in enqueue health check notification")
+		}
+	}
+}
+
+func (r SHealthCheckJobHandler) silenced(ctx *contextplus.Context, healthCheck entities.HealthCheck) bool {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	silence, err := r.iUnitOfWork.SilenceRepository().Active(ctx, healthCheck.TenantId, healthCheck.Id, time.Now())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in find active silence")
+
+		return false
+	}
+
+	return silence != nil
+}
+
+// sendNotification enqueues the notification for the channels whose label selector routes the health check to
+// them, and falls back to the default receivers of every provider when none does.
+func (r SHealthCheckJobHandler) sendNotification(ctx *contextplus.Context, healthCheck entities.HealthCheck, subject string, msg string) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	notificationChannels, err := r.iUnitOfWork.NotificationChannelRepository().Routed(ctx, healthCheck.TenantId, healthCheck.Id)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in get routed notification channels")
+	}
+
+	if len(notificationChannels) > 0 {
+		for _, notificationChannel := range notificationChannels {
+			if err = enqueueChannelNotification(ctx, r.iUnitOfWork, notificationChannel, subject, msg); err != nil {
+				span.SetTag("error", true)
+				span.LogKV("err", err)
+				r.iLogger.WithError(err).WithUint("notificationChannelId", notificationChannel.Id).Error(ctx, "error in enqueue health check notification")
+			}
+		}
+		return
+	}
+
+	for _, provider := range r.iNotification.Providers() {
+		notificationDelivery := entities.NewNotificationDelivery(healthCheck.TenantId, provider, "", subject, msg)
+		if err := r.iUnitOfWork.NotificationDeliveryRepository().Create(ctx, &notificationDelivery); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithString("provider", provider.String()).WithString("subject", subject).WithString("msg", msg).Error(ctx, "error in enqueue health check notification")
+		}
+	}
 }
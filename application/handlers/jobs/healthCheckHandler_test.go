@@ -1,15 +1,20 @@
 package jobs
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-logger"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"health-check/application/interfaces"
 	"health-check/domain/entities"
 	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
 	"health-check/pkg/genericRepository"
 	"health-check/pkg/tracer"
 	"testing"
@@ -17,16 +22,29 @@ import (
 )
 
 type sMockHealthCheckJobHandler struct {
-	iLogger                       *logger.MockILogger
-	iTracer                       *tracer.MockITracer
-	iSpan                         *tracer.MockISpan
-	iRedis                        *interfaces.MockIRedis
-	iCron                         *interfaces.MockICron
-	iRest                         *interfaces.MockIRest
-	iNotification                 *interfaces.MockINotification
-	iHealthCheckRepository        *interfaces.MockIHealthCheckRepository
-	iHealthCheckRequestRepository *interfaces.MockIHealthCheckRequestRepository
-	iUnitOfWork                   *interfaces.MockIUnitOfWork
+	iLogger                          *logger.MockILogger
+	iTracer                          *tracer.MockITracer
+	iSpan                            *tracer.MockISpan
+	iRedis                           *interfaces.MockIRedis
+	iCron                            *interfaces.MockICron
+	iRest                            *interfaces.MockIRest
+	iGrpcProbe                       *interfaces.MockIGrpcProbe
+	iDnsProbe                        *interfaces.MockIDnsProbe
+	iPostgresProbe                   *interfaces.MockIPostgresProbe
+	iRedisProbe                      *interfaces.MockIRedisProbe
+	iCipher                          *interfaces.MockICipher
+	iNotification                    *interfaces.MockINotification
+	iHealthCheckRepository           *interfaces.MockIHealthCheckRepository
+	iHealthCheckRequestRepository    *interfaces.MockIHealthCheckRequestRepository
+	iSecretRepository                *interfaces.MockISecretRepository
+	iIncidentRepository              *interfaces.MockIIncidentRepository
+	iSilenceRepository               *interfaces.MockISilenceRepository
+	iNotificationDeliveryRepository  *interfaces.MockINotificationDeliveryRepository
+	iNotificationChannelRepository   *interfaces.MockINotificationChannelRepository
+	iEscalationPolicyRepository      *interfaces.MockIEscalationPolicyRepository
+	iHealthCheckDependencyRepository *interfaces.MockIHealthCheckDependencyRepository
+	iTagRepository                   *interfaces.MockITagRepository
+	iUnitOfWork                      *interfaces.MockIUnitOfWork
 
 	callAddJob              func(ctx *contextplus.Context, healthCheck entities.HealthCheck)
 	callAddJobTimes         int
@@ -40,7 +58,7 @@ type sMockHealthCheckJobHandler struct {
 	callSendRequestTimes         int
 	callSendRequestTimesExpected int
 
-	callSendNotification              func(ctx *contextplus.Context, subject string, msg string)
+	callSendNotification              func(ctx *contextplus.Context, healthCheck entities.HealthCheck, subject string, msg string)
 	callSendNotificationTimes         int
 	callSendNotificationTimesExpected int
 }
@@ -48,16 +66,29 @@ type sMockHealthCheckJobHandler struct {
 func setup(t *testing.T) (mock *sMockHealthCheckJobHandler) {
 	mockController := gomock.NewController(t)
 	mock = &sMockHealthCheckJobHandler{
-		iLogger:                       logger.NewMockILogger(mockController),
-		iTracer:                       tracer.NewMockITracer(mockController),
-		iSpan:                         tracer.NewMockISpan(mockController),
-		iRedis:                        interfaces.NewMockIRedis(mockController),
-		iCron:                         interfaces.NewMockICron(mockController),
-		iRest:                         interfaces.NewMockIRest(mockController),
-		iNotification:                 interfaces.NewMockINotification(mockController),
-		iHealthCheckRepository:        interfaces.NewMockIHealthCheckRepository(mockController),
-		iHealthCheckRequestRepository: interfaces.NewMockIHealthCheckRequestRepository(mockController),
-		iUnitOfWork:                   interfaces.NewMockIUnitOfWork(mockController),
+		iLogger:                          logger.NewMockILogger(mockController),
+		iTracer:                          tracer.NewMockITracer(mockController),
+		iSpan:                            tracer.NewMockISpan(mockController),
+		iRedis:                           interfaces.NewMockIRedis(mockController),
+		iCron:                            interfaces.NewMockICron(mockController),
+		iRest:                            interfaces.NewMockIRest(mockController),
+		iGrpcProbe:                       interfaces.NewMockIGrpcProbe(mockController),
+		iDnsProbe:                        interfaces.NewMockIDnsProbe(mockController),
+		iPostgresProbe:                   interfaces.NewMockIPostgresProbe(mockController),
+		iRedisProbe:                      interfaces.NewMockIRedisProbe(mockController),
+		iCipher:                          interfaces.NewMockICipher(mockController),
+		iNotification:                    interfaces.NewMockINotification(mockController),
+		iHealthCheckRepository:           interfaces.NewMockIHealthCheckRepository(mockController),
+		iHealthCheckRequestRepository:    interfaces.NewMockIHealthCheckRequestRepository(mockController),
+		iSecretRepository:                interfaces.NewMockISecretRepository(mockController),
+		iIncidentRepository:              interfaces.NewMockIIncidentRepository(mockController),
+		iSilenceRepository:               interfaces.NewMockISilenceRepository(mockController),
+		iNotificationDeliveryRepository:  interfaces.NewMockINotificationDeliveryRepository(mockController),
+		iNotificationChannelRepository:   interfaces.NewMockINotificationChannelRepository(mockController),
+		iEscalationPolicyRepository:      interfaces.NewMockIEscalationPolicyRepository(mockController),
+		iHealthCheckDependencyRepository: interfaces.NewMockIHealthCheckDependencyRepository(mockController),
+		iTagRepository:                   interfaces.NewMockITagRepository(mockController),
+		iUnitOfWork:                      interfaces.NewMockIUnitOfWork(mockController),
 	}
 	t.Cleanup(func() {
 		mock.callAddJob = nil
@@ -173,8 +204,17 @@ func TestStart(t *testing.T) {
 				mock.iRedis,
 				mock.iCron,
 				mock.iRest,
+				mock.iGrpcProbe,
+				mock.iDnsProbe,
+				mock.iPostgresProbe,
+				mock.iRedisProbe,
+				mock.iCipher,
 				mock.iNotification,
 				mock.iUnitOfWork,
+				21,
+				25,
+				50,
+				"CHECK_",
 			)
 			tableTest.mock(mock, tableTest.arg.in)
 			healthCheckJobHandler.callAddJob = mock.callAddJob
@@ -352,8 +392,17 @@ func TestAddJob(t *testing.T) {
 				mock.iRedis,
 				mock.iCron,
 				mock.iRest,
+				mock.iGrpcProbe,
+				mock.iDnsProbe,
+				mock.iPostgresProbe,
+				mock.iRedisProbe,
+				mock.iCipher,
 				mock.iNotification,
 				mock.iUnitOfWork,
+				21,
+				25,
+				50,
+				"CHECK_",
 			)
 			tableTest.mock(mock, tableTest.arg.in)
 			healthCheckJobHandler.callAddJob = mock.callAddJob
@@ -366,3 +415,704 @@ func TestAddJob(t *testing.T) {
 		})
 	}
 }
+
+func TestProbeGrpc(t *testing.T) {
+	tableTests := []struct {
+		name       string
+		status     string
+		err        error
+		statusCode int
+		body       string
+	}{
+		{
+			name:       "serving is healthy",
+			status:     "SERVING",
+			statusCode: 200,
+		},
+		{
+			name:       "not serving is unhealthy",
+			status:     "NOT_SERVING",
+			statusCode: 503,
+		},
+		{
+			name:       "failed call is unhealthy and keeps the error",
+			status:     "Unavailable",
+			err:        errors.New("connection refused"),
+			statusCode: 503,
+			body:       "connection refused",
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckJobHandler := newHealthCheckJobHandler(
+				mock.iLogger,
+				mock.iTracer,
+				mock.iRedis,
+				mock.iCron,
+				mock.iRest,
+				mock.iGrpcProbe,
+				mock.iDnsProbe,
+				mock.iPostgresProbe,
+				mock.iRedisProbe,
+				mock.iCipher,
+				mock.iNotification,
+				mock.iUnitOfWork,
+				21,
+				25,
+				50,
+				"CHECK_",
+			)
+			ctx := contextplus.Background()
+			settings := valueObjects.GrpcProbeSettings{Service: "payment"}
+			healthCheck := entities.HealthCheck{
+				Id:       1,
+				Url:      "localhost:50051",
+				Type:     enums.ProbeTypeGrpc,
+				Settings: datatypes.NewJSONType(valueObjects.ProbeSettings{Grpc: &settings}),
+			}
+
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+			mock.iSpan.EXPECT().Finish().Times(1)
+			mock.iGrpcProbe.EXPECT().Check(ctx, healthCheck.Url, settings).Return(tableTest.status, nil, tableTest.err).Times(1)
+			if tableTest.err != nil {
+				mock.iSpan.EXPECT().SetTag("error", true).Times(1)
+				mock.iSpan.EXPECT().LogKV("err", tableTest.err).Times(1)
+				mock.iLogger.EXPECT().WithError(tableTest.err).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithUint("healthCheckId", healthCheck.Id).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().Error(ctx, "error in check grpc health").Times(1)
+			}
+
+			healthCheckRequest, ok := healthCheckJobHandler.probe(ctx, healthCheck)
+
+			assert.True(t, ok)
+			assert.Equal(t, tableTest.status, healthCheckRequest.Status)
+			assert.Equal(t, tableTest.statusCode, healthCheckRequest.StatusCode)
+			assert.Equal(t, tableTest.body, healthCheckRequest.Body)
+		})
+	}
+}
+
+func TestProbeDns(t *testing.T) {
+	tableTests := []struct {
+		name       string
+		settings   valueObjects.DnsProbeSettings
+		status     string
+		records    []string
+		lookupErr  error
+		statusCode int
+		body       string
+	}{
+		{
+			name:       "expected records are healthy",
+			settings:   valueObjects.DnsProbeSettings{RecordType: enums.DnsRecordTypeA, ExpectedValues: []string{"10.0.0.2", "10.0.0.1"}},
+			status:     "NOERROR",
+			records:    []string{"10.0.0.1", "10.0.0.2"},
+			statusCode: 200,
+			body:       `{"records":["10.0.0.1","10.0.0.2"]}`,
+		},
+		{
+			name:       "failed assertion is unhealthy",
+			settings:   valueObjects.DnsProbeSettings{RecordType: enums.DnsRecordTypeA, MinRecords: 2},
+			status:     "NOERROR",
+			records:    []string{"10.0.0.1"},
+			statusCode: 503,
+			body:       `{"records":["10.0.0.1"],"error":"got 1 records, expected at least 2"}`,
+		},
+		{
+			name:       "failed lookup is unhealthy",
+			settings:   valueObjects.DnsProbeSettings{RecordType: enums.DnsRecordTypeTXT},
+			status:     "NXDOMAIN",
+			lookupErr:  errors.New("no such host"),
+			statusCode: 503,
+			body:       `{"records":null,"error":"no such host"}`,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckJobHandler := newHealthCheckJobHandler(
+				mock.iLogger,
+				mock.iTracer,
+				mock.iRedis,
+				mock.iCron,
+				mock.iRest,
+				mock.iGrpcProbe,
+				mock.iDnsProbe,
+				mock.iPostgresProbe,
+				mock.iRedisProbe,
+				mock.iCipher,
+				mock.iNotification,
+				mock.iUnitOfWork,
+				21,
+				25,
+				50,
+				"CHECK_",
+			)
+			ctx := contextplus.Background()
+			healthCheck := entities.HealthCheck{
+				Id:       1,
+				Url:      "example.com",
+				Type:     enums.ProbeTypeDns,
+				Settings: datatypes.NewJSONType(valueObjects.ProbeSettings{Dns: &tableTest.settings}),
+			}
+
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+			mock.iSpan.EXPECT().Finish().Times(1)
+			mock.iDnsProbe.EXPECT().Lookup(ctx, healthCheck.Url, tableTest.settings).Return(tableTest.status, tableTest.records, tableTest.lookupErr).Times(1)
+			if tableTest.statusCode != 200 {
+				mock.iSpan.EXPECT().SetTag("error", true).Times(1)
+				mock.iSpan.EXPECT().LogKV("err", gomock.Any()).Times(1)
+				mock.iLogger.EXPECT().WithError(gomock.Any()).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithUint("healthCheckId", healthCheck.Id).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().Error(ctx, "error in check dns records").Times(1)
+			}
+
+			healthCheckRequest, ok := healthCheckJobHandler.probe(ctx, healthCheck)
+
+			assert.True(t, ok)
+			assert.Equal(t, tableTest.status, healthCheckRequest.Status)
+			assert.Equal(t, tableTest.statusCode, healthCheckRequest.StatusCode)
+			assert.JSONEq(t, tableTest.body, healthCheckRequest.Body)
+		})
+	}
+}
+
+func TestProbeDatastore(t *testing.T) {
+	tableTests := []struct {
+		name       string
+		probeType  enums.ProbeType
+		settings   valueObjects.ProbeSettings
+		result     string
+		queryErr   error
+		status     string
+		statusCode int
+		body       string
+	}{
+		{
+			name:       "postgres default query is healthy",
+			probeType:  enums.ProbeTypePostgres,
+			settings:   valueObjects.ProbeSettings{Postgres: &valueObjects.PostgresProbeSettings{Username: "monitor", Password: "enc:sealed"}},
+			result:     "1",
+			status:     "OK",
+			statusCode: 200,
+			body:       `{"result":"1","connectDuration":0,"queryDuration":0}`,
+		},
+		{
+			name:       "redis unexpected result is unhealthy",
+			probeType:  enums.ProbeTypeRedis,
+			settings:   valueObjects.ProbeSettings{Redis: &valueObjects.RedisProbeSettings{Password: "enc:sealed", Command: []string{"GET", "mode"}, Expected: "primary"}},
+			result:     "replica",
+			status:     "UNEXPECTED_RESULT",
+			statusCode: 503,
+			body:       `{"result":"replica","connectDuration":0,"queryDuration":0,"error":"unexpected result: got \"replica\", expected \"primary\""}`,
+		},
+		{
+			name:       "failed connection is unhealthy",
+			probeType:  enums.ProbeTypePostgres,
+			settings:   valueObjects.ProbeSettings{Postgres: &valueObjects.PostgresProbeSettings{Password: "enc:sealed"}},
+			queryErr:   errors.New("connection refused"),
+			status:     "ERROR",
+			statusCode: 503,
+			body:       `{"result":"","connectDuration":0,"queryDuration":0,"error":"connection refused"}`,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckJobHandler := newHealthCheckJobHandler(
+				mock.iLogger,
+				mock.iTracer,
+				mock.iRedis,
+				mock.iCron,
+				mock.iRest,
+				mock.iGrpcProbe,
+				mock.iDnsProbe,
+				mock.iPostgresProbe,
+				mock.iRedisProbe,
+				mock.iCipher,
+				mock.iNotification,
+				mock.iUnitOfWork,
+				21,
+				25,
+				50,
+				"CHECK_",
+			)
+			ctx := contextplus.Background()
+			healthCheck := entities.HealthCheck{
+				Id:       1,
+				Url:      "postgres://db.internal:5432/app",
+				Type:     tableTest.probeType,
+				Settings: datatypes.NewJSONType(tableTest.settings),
+			}
+
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+			mock.iSpan.EXPECT().Finish().Times(1)
+			field := "settings.redis.password"
+			if tableTest.probeType == enums.ProbeTypePostgres {
+				field = "settings.postgres.password"
+			}
+			mock.iCipher.EXPECT().Decrypt(healthCheck.TenantId, field, "enc:sealed").Return("secret", nil).Times(1)
+			if tableTest.probeType == enums.ProbeTypePostgres {
+				want := *tableTest.settings.Postgres
+				want.Password = "secret"
+				want.Query = "SELECT 1"
+				mock.iPostgresProbe.EXPECT().Query(ctx, healthCheck.Url, want).Return(tableTest.result, time.Duration(0), time.Duration(0), tableTest.queryErr).Times(1)
+			} else {
+				want := *tableTest.settings.Redis
+				want.Password = "secret"
+				mock.iRedisProbe.EXPECT().Command(ctx, healthCheck.Url, want).Return(tableTest.result, time.Duration(0), time.Duration(0), tableTest.queryErr).Times(1)
+			}
+			if tableTest.statusCode != 200 {
+				mock.iSpan.EXPECT().SetTag("error", true).Times(1)
+				mock.iSpan.EXPECT().LogKV("err", gomock.Any()).Times(1)
+				mock.iLogger.EXPECT().WithError(gomock.Any()).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithUint("healthCheckId", healthCheck.Id).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().Error(ctx, "error in check datastore").Times(1)
+			}
+
+			healthCheckRequest, ok := healthCheckJobHandler.probe(ctx, healthCheck)
+
+			assert.True(t, ok)
+			assert.Equal(t, tableTest.status, healthCheckRequest.Status)
+			assert.Equal(t, tableTest.statusCode, healthCheckRequest.StatusCode)
+			assert.JSONEq(t, tableTest.body, healthCheckRequest.Body)
+		})
+	}
+}
+
+func TestProbeMultiStep(t *testing.T) {
+	settings := valueObjects.ProbeSettings{MultiStep: &valueObjects.MultiStepProbeSettings{Steps: []valueObjects.HttpStep{
+		{
+			Name:    "login",
+			Method:  enums.HttpMethodPOST,
+			Url:     "/login",
+			Body:    map[string]any{"username": "monitor"},
+			Extract: []valueObjects.HttpStepExtraction{{Variable: "token", Source: enums.HttpStepSourceJsonPath, Path: "$.data.token"}},
+		},
+		{
+			Name:    "profile",
+			Method:  enums.HttpMethodGET,
+			Url:     "/users/{{ .token }}",
+			Headers: map[string]string{"Authorization": "Bearer {{ .token }}"},
+			Assertions: []valueObjects.HttpStepAssertion{
+				{Source: enums.HttpStepSourceStatus, Operator: enums.AssertionOperatorEquals, Value: "200"},
+				{Source: enums.HttpStepSourceJsonPath, Path: "$.roles[0]", Operator: enums.AssertionOperatorEquals, Value: "admin"},
+			},
+		},
+	}}}
+
+	tableTests := []struct {
+		name       string
+		profile    string
+		status     string
+		statusCode int
+		body       string
+	}{
+		{
+			name:       "every step passes",
+			profile:    `{"roles":["admin"]}`,
+			status:     "OK",
+			statusCode: 200,
+			body:       `{"steps":[{"name":"login","statusCode":201,"duration":0},{"name":"profile","statusCode":200,"duration":0}]}`,
+		},
+		{
+			name:       "failed assertion identifies the step",
+			profile:    `{"roles":["viewer"]}`,
+			status:     "STEP_FAILED",
+			statusCode: 503,
+			body:       `{"steps":[{"name":"login","statusCode":201,"duration":0},{"name":"profile","statusCode":200,"duration":0,"error":"jsonPath $.roles[0]: got \"viewer\", expected \"admin\""}],"failedStep":"profile","error":"jsonPath $.roles[0]: got \"viewer\", expected \"admin\""}`,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckJobHandler := newHealthCheckJobHandler(
+				mock.iLogger,
+				mock.iTracer,
+				mock.iRedis,
+				mock.iCron,
+				mock.iRest,
+				mock.iGrpcProbe,
+				mock.iDnsProbe,
+				mock.iPostgresProbe,
+				mock.iRedisProbe,
+				mock.iCipher,
+				mock.iNotification,
+				mock.iUnitOfWork,
+				21,
+				25,
+				50,
+				"CHECK_",
+			)
+			ctx := contextplus.Background()
+			healthCheck := entities.HealthCheck{
+				Id:       1,
+				Url:      "https://api.example.com/v1/",
+				Type:     enums.ProbeTypeMultiStep,
+				Settings: datatypes.NewJSONType(settings),
+			}
+
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+			mock.iSpan.EXPECT().Finish().Times(1)
+			mock.iRest.EXPECT().Execute(ctx, enums.HttpMethodPOST, "https://api.example.com/login", map[string]string{}, map[string]any{"username": "monitor"}, nil).
+				Return(201, nil, `{"data":{"token":"abc"}}`, nil).Times(1)
+			mock.iRest.EXPECT().Execute(ctx, enums.HttpMethodGET, "https://api.example.com/users/abc", map[string]string{"Authorization": "Bearer abc"}, nil, nil).
+				Return(200, nil, tableTest.profile, nil).Times(1)
+			if tableTest.statusCode != 200 {
+				mock.iSpan.EXPECT().SetTag("error", true).Times(1)
+				mock.iSpan.EXPECT().LogKV("err", gomock.Any()).Times(1)
+				mock.iLogger.EXPECT().WithError(gomock.Any()).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithUint("healthCheckId", healthCheck.Id).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().Error(ctx, "error in run multi step health check").Times(1)
+			}
+
+			healthCheckRequest, ok := healthCheckJobHandler.probe(ctx, healthCheck)
+
+			assert.True(t, ok)
+			assert.Equal(t, tableTest.status, healthCheckRequest.Status)
+			assert.Equal(t, tableTest.statusCode, healthCheckRequest.StatusCode)
+			assert.JSONEq(t, tableTest.body, healthCheckRequest.Body)
+		})
+	}
+}
+
+func TestProbeHttpTemplate(t *testing.T) {
+	t.Setenv("CHECK_HOST", "api.example.com")
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte(`{"name":"probe"}`))
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	tableTests := []struct {
+		name       string
+		url        string
+		stored     bool
+		literal    bool
+		status     string
+		statusCode int
+		body       string
+	}{
+		{
+			name:       "health check without templates sends the request as stored",
+			url:        "https://api.example.com/{{orders}}",
+			literal:    true,
+			statusCode: 200,
+			body:       "ok",
+		},
+		{
+			name:       "secrets are expanded and masked in the result",
+			url:        `https://{{ env "CHECK_HOST" }}/orders`,
+			statusCode: 200,
+			body:       "token *** accepted",
+		},
+		{
+			name:       "secrets the health check does not define are read once from the secret store",
+			url:        `https://{{ env "CHECK_HOST" }}/orders`,
+			stored:     true,
+			statusCode: 200,
+			body:       "token *** accepted",
+		},
+		{
+			name:       "environment variables without the prefix are refused",
+			url:        `https://{{ env "ENCRYPTION_KEY" }}/orders`,
+			status:     "TEMPLATE_ERROR",
+			statusCode: 503,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckJobHandler := newHealthCheckJobHandler(
+				mock.iLogger,
+				mock.iTracer,
+				mock.iRedis,
+				mock.iCron,
+				mock.iRest,
+				mock.iGrpcProbe,
+				mock.iDnsProbe,
+				mock.iPostgresProbe,
+				mock.iRedisProbe,
+				mock.iCipher,
+				mock.iNotification,
+				mock.iUnitOfWork,
+				21,
+				25,
+				50,
+				"CHECK_",
+			)
+			ctx := contextplus.Background()
+			healthCheck := entities.HealthCheck{
+				Id:       1,
+				Url:      tableTest.url,
+				Method:   enums.HttpMethodPOST,
+				Headers:  datatypes.NewJSONType(map[string]string{"Authorization": `Bearer {{ secret "token" }}`, "X-Signature": `{{ hmac "sha256" (secret "token") body }}`}),
+				Body:     datatypes.NewJSONType(map[string]any{"name": "probe"}),
+				Type:     enums.ProbeTypeHttp,
+				Settings: datatypes.NewJSONType(valueObjects.ProbeSettings{Templates: true, Secrets: map[string]string{"token": "enc:sealed"}}),
+			}
+
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(1)
+			mock.iSpan.EXPECT().Finish().Times(1)
+			switch {
+			case tableTest.literal:
+				healthCheck.Settings = datatypes.NewJSONType(valueObjects.ProbeSettings{})
+			case tableTest.stored:
+				healthCheck.Settings = datatypes.NewJSONType(valueObjects.ProbeSettings{Templates: true})
+				mock.iUnitOfWork.EXPECT().SecretRepository().Return(mock.iSecretRepository).Times(1)
+				mock.iSecretRepository.EXPECT().SingleOrDefault(ctx, genericRepository.Equal("tenant_id", healthCheck.TenantId), genericRepository.Equal("name", "token")).Return(&entities.Secret{TenantId: healthCheck.TenantId, Name: "token", Value: "enc:stored"}, nil).Times(1)
+				mock.iCipher.EXPECT().Decrypt(healthCheck.TenantId, "secrets.token", "enc:stored").Return("s3cret", nil).Times(1)
+			default:
+				mock.iCipher.EXPECT().Decrypt(healthCheck.TenantId, "settings.secrets.token", "enc:sealed").Return("s3cret", nil).Times(1)
+			}
+			switch {
+			case tableTest.literal:
+				mock.iRest.EXPECT().Execute(ctx, enums.HttpMethodPOST, tableTest.url, healthCheck.Headers.Data(), healthCheck.Body.Data(), valueObjects.Sensitive(nil)).Return(200, nil, "ok", nil).Times(1)
+			case tableTest.statusCode == 200:
+				mock.iRest.EXPECT().Execute(
+					ctx,
+					enums.HttpMethodPOST,
+					"https://api.example.com/orders",
+					map[string]string{"Authorization": "Bearer s3cret", "X-Signature": signature},
+					map[string]any{"name": "probe"},
+					valueObjects.Sensitive{"api.example.com", "s3cret"},
+				).Return(200, nil, "token s3cret accepted", nil).Times(1)
+			default:
+				mock.iSpan.EXPECT().SetTag("error", true).Times(1)
+				mock.iSpan.EXPECT().LogKV("err", gomock.Any()).Times(1)
+				mock.iLogger.EXPECT().WithError(gomock.Any()).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithUint("healthCheckId", healthCheck.Id).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().Error(ctx, "error in render request templates").Times(1)
+			}
+
+			healthCheckRequest, ok := healthCheckJobHandler.probe(ctx, healthCheck)
+
+			assert.True(t, ok)
+			assert.Equal(t, tableTest.status, healthCheckRequest.Status)
+			assert.Equal(t, tableTest.statusCode, healthCheckRequest.StatusCode)
+			if tableTest.body != "" {
+				assert.Equal(t, tableTest.body, healthCheckRequest.Body)
+			}
+		})
+	}
+}
+
+func TestHeartbeatState(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	healthCheck := entities.NewHealthCheck(
+		[16]byte{}, "backup", "", "", "", "1h", "", "", nil, nil, enums.ProbeTypeHeartbeat, valueObjects.ProbeSettings{Heartbeat: &valueObjects.HeartbeatProbeSettings{GraceSecond: 300}}, enums.StatusStart, nil,
+	)
+	healthCheck.Id = 7
+	healthCheck.CreatedAt = createdAt
+
+	ping := func(signal enums.HeartbeatSignal, at time.Time) *entities.HealthCheckRequest {
+		healthCheckRequest := entities.NewHealthCheckHeartbeatRequest(healthCheck.Id, signal, "", 0)
+		healthCheckRequest.CreatedAt = at
+		return &healthCheckRequest
+	}
+	missed := entities.NewHealthCheckMissedRequest(healthCheck.Id, createdAt.Add(time.Hour+5*time.Minute))
+
+	tableTests := []struct {
+		name     string
+		last     *entities.HealthCheckRequest
+		now      time.Time
+		status   string
+		isMissed bool
+	}{
+		{name: "never pinged within the grace", now: createdAt.Add(time.Hour + 4*time.Minute)},
+		{name: "never pinged after the grace", now: createdAt.Add(time.Hour + 6*time.Minute), status: entities.HealthCheckRequestStatusMissed, isMissed: true},
+		{name: "missed is not recorded twice", last: &missed, now: createdAt.Add(3 * time.Hour), status: entities.HealthCheckRequestStatusMissed},
+		{name: "started run is pending", last: ping(enums.HeartbeatSignalStart, createdAt.Add(time.Hour)), now: createdAt.Add(time.Hour + 30*time.Minute)},
+		{name: "started run that never finished", last: ping(enums.HeartbeatSignalStart, createdAt.Add(time.Hour)), now: createdAt.Add(2*time.Hour + 6*time.Minute), status: entities.HealthCheckRequestStatusMissed, isMissed: true},
+		{name: "success ping", last: ping(enums.HeartbeatSignalSuccess, createdAt.Add(time.Hour)), now: createdAt.Add(2 * time.Hour), status: enums.HeartbeatSignalSuccess.String()},
+		{name: "fail ping", last: ping(enums.HeartbeatSignalFail, createdAt.Add(time.Hour)), now: createdAt.Add(2 * time.Hour), status: enums.HeartbeatSignalFail.String()},
+	}
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			healthCheckRequest, isMissed, err := heartbeatState(healthCheck, tableTest.last, tableTest.now)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tableTest.isMissed, isMissed)
+			if tableTest.status == "" {
+				assert.Nil(t, healthCheckRequest)
+				return
+			}
+			assert.Equal(t, tableTest.status, healthCheckRequest.Status)
+		})
+	}
+}
+
+func TestHandleIncidentFlapping(t *testing.T) {
+	escalationPolicyId := uint(3)
+	suppressed := func() *entities.Incident {
+		incident := entities.NewIncident([16]byte{}, 1, false)
+		incident.Id = 9
+		incident.Suppress()
+		return &incident
+	}
+
+	tableTests := []struct {
+		name               string
+		escalationPolicyId *uint
+		incident           *entities.Incident
+		statusCode         int
+		flapping           bool
+		concurrent         bool
+		notifications      int
+		assert             func(t *testing.T, incident entities.Incident)
+	}{
+		{
+			name:       "down while flapping opens a suppressed incident",
+			statusCode: 503,
+			flapping:   true,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.True(t, incident.Suppressed)
+				assert.Nil(t, incident.NextEscalationAt)
+			},
+		},
+		{
+			name:       "still flapping keeps the incident suppressed",
+			incident:   suppressed(),
+			statusCode: 503,
+			flapping:   true,
+		},
+		{
+			name:          "flapping cleared while down announces the incident",
+			incident:      suppressed(),
+			statusCode:    503,
+			notifications: 1,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.False(t, incident.Suppressed)
+				assert.Nil(t, incident.NextEscalationAt)
+			},
+		},
+		{
+			name:               "flapping cleared while down starts the escalation",
+			escalationPolicyId: &escalationPolicyId,
+			incident:           suppressed(),
+			statusCode:         503,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.False(t, incident.Suppressed)
+				assert.NotNil(t, incident.NextEscalationAt)
+			},
+		},
+		{
+			name:       "flapping cleared after another run resolved the incident is not announced",
+			incident:   suppressed(),
+			statusCode: 503,
+			concurrent: true,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.False(t, incident.Suppressed)
+			},
+		},
+		{
+			name:       "resolving a suppressed incident is not announced",
+			incident:   suppressed(),
+			statusCode: 200,
+			assert: func(t *testing.T, incident entities.Incident) {
+				assert.Equal(t, enums.IncidentStatusResolved, incident.Status)
+			},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			healthCheckJobHandler := newHealthCheckJobHandler(
+				mock.iLogger,
+				mock.iTracer,
+				mock.iRedis,
+				mock.iCron,
+				mock.iRest,
+				mock.iGrpcProbe,
+				mock.iDnsProbe,
+				mock.iPostgresProbe,
+				mock.iRedisProbe,
+				mock.iCipher,
+				mock.iNotification,
+				mock.iUnitOfWork,
+				21,
+				25,
+				50,
+				"CHECK_",
+			)
+			ctx := contextplus.Background()
+			healthCheck := entities.HealthCheck{Id: 1, EscalationPolicyId: tableTest.escalationPolicyId}
+			healthCheckRequest := entities.HealthCheckRequest{HealthCheckId: healthCheck.Id, StatusCode: tableTest.statusCode}
+
+			var saved *entities.Incident
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).MinTimes(1)
+			mock.iSpan.EXPECT().Finish().MinTimes(1)
+			mock.iUnitOfWork.EXPECT().IncidentRepository().Return(mock.iIncidentRepository).AnyTimes()
+			mock.iIncidentRepository.EXPECT().LastOrDefault(ctx, gomock.Any(), gomock.Any()).Return(tableTest.incident, nil).Times(1)
+			mock.iIncidentRepository.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, incident *entities.Incident) error {
+				saved = incident
+				return nil
+			}).MaxTimes(1)
+			mock.iIncidentRepository.EXPECT().UpdateSuppression(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, incident *entities.Incident) (bool, error) {
+				saved = incident
+				return !tableTest.concurrent, nil
+			}).MaxTimes(1)
+			mock.iIncidentRepository.EXPECT().Resolve(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, incident *entities.Incident) (bool, error) {
+				saved = incident
+				return !tableTest.concurrent, nil
+			}).MaxTimes(1)
+			mock.iUnitOfWork.EXPECT().SilenceRepository().Return(mock.iSilenceRepository).Times(tableTest.notifications)
+			mock.iSilenceRepository.EXPECT().Active(ctx, healthCheck.TenantId, healthCheck.Id, gomock.Any()).Return(nil, nil).Times(tableTest.notifications)
+
+			mock.callSendNotification = func(ctx *contextplus.Context, healthCheck entities.HealthCheck, subject string, msg string) {
+				mock.callSendNotificationTimes++
+			}
+			healthCheckJobHandler.callSendNotification = mock.callSendNotification
+
+			healthCheckJobHandler.handleIncident(ctx, healthCheck, healthCheckRequest, tableTest.flapping)
+
+			assert.Equal(t, tableTest.notifications, mock.callSendNotificationTimes)
+			if tableTest.assert == nil {
+				assert.Nil(t, saved)
+				return
+			}
+			assert.NotNil(t, saved)
+			tableTest.assert(t, *saved)
+		})
+	}
+}
+
+func TestPercentStateChange(t *testing.T) {
+	tableTests := []struct {
+		name    string
+		results []bool
+		want    float64
+	}{
+		{
+			name:    "stable",
+			results: []bool{true, true, true, true, true},
+			want:    0,
+		},
+		{
+			name:    "alternating",
+			results: []bool{true, false, true, false, true},
+			want:    100,
+		},
+		{
+			name:    "newest change weighs more than oldest",
+			results: []bool{true, true, true, true, false},
+			want:    30,
+		},
+		{
+			name:    "oldest change weighs less than newest",
+			results: []bool{false, true, true, true, true},
+			want:    20,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			assert.InDelta(t, tableTest.want, percentStateChange(tableTest.results), 0.0001)
+		})
+	}
+}
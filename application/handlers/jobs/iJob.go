@@ -2,8 +2,10 @@ package jobs
 
 import (
 	"github.com/ehsandavari/go-context-plus"
+	"health-check/application/handlers/commands"
 	"health-check/infrastructure"
 	"health-check/persistence"
+	"time"
 )
 
 type IJob interface {
@@ -12,11 +14,68 @@ type IJob interface {
 }
 
 type Jobs struct {
-	HealthCheck IJob
+	HealthCheck          IHealthCheckJob
+	NotificationDelivery IJob
+	Escalation           IJob
+	Digest               IJob
+	GitOps               IGitOpsJob
+	SecretRotation       IJob
 }
 
-func NewJobs(infrastructure *infrastructure.Infrastructure, persistence *persistence.Persistence) Jobs {
+func NewJobs(infrastructure *infrastructure.Infrastructure, persistence *persistence.Persistence, commands commands.Commands) Jobs {
 	return Jobs{
-		HealthCheck: newHealthCheckJobHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.IRedis, infrastructure.ICron, infrastructure.IRest, infrastructure.INotification, persistence.IUnitOfWork),
+		HealthCheck: newHealthCheckJobHandler(
+			infrastructure.ILogger,
+			infrastructure.ITracer,
+			infrastructure.IRedis,
+			infrastructure.ICron,
+			infrastructure.IRest,
+			infrastructure.IGrpcProbe,
+			infrastructure.IDnsProbe,
+			infrastructure.IPostgresProbe,
+			infrastructure.IRedisProbe,
+			infrastructure.ICipher,
+			infrastructure.INotification,
+			persistence.IUnitOfWork,
+			infrastructure.SConfig.Flapping.WindowSize,
+			infrastructure.SConfig.Flapping.LowThreshold,
+			infrastructure.SConfig.Flapping.HighThreshold,
+			infrastructure.SConfig.Template.EnvPrefix,
+		),
+		NotificationDelivery: newNotificationDeliveryJobHandler(
+			infrastructure.ILogger,
+			infrastructure.ITracer,
+			infrastructure.ICron,
+			infrastructure.INotification,
+			persistence.IUnitOfWork,
+			time.Duration(infrastructure.SConfig.Notification.Delivery.PollIntervalSecond)*time.Second,
+			infrastructure.SConfig.Notification.Delivery.BatchSize,
+			infrastructure.SConfig.Notification.Delivery.MaxAttempts,
+			time.Duration(infrastructure.SConfig.Notification.Delivery.BaseBackoffSecond)*time.Second,
+			time.Duration(infrastructure.SConfig.Notification.Delivery.MaxBackoffSecond)*time.Second,
+			infrastructure.SConfig.Notification.RateLimitPerMinute(),
+		),
+		Escalation: newEscalationJobHandler(
+			infrastructure.ILogger,
+			infrastructure.ITracer,
+			infrastructure.ICron,
+			persistence.IUnitOfWork,
+			time.Duration(infrastructure.SConfig.Escalation.PollIntervalSecond)*time.Second,
+			infrastructure.SConfig.Escalation.BatchSize,
+		),
+		Digest: newDigestJobHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.IRedis, infrastructure.ICron, persistence.IUnitOfWork),
+		GitOps: newGitOpsJobHandler(
+			infrastructure.ILogger,
+			infrastructure.ITracer,
+			infrastructure.ICron,
+			commands.HealthCheckImport,
+			*infrastructure.SConfig.GitOps.IsEnabled,
+			infrastructure.SConfig.GitOps.Directory,
+			infrastructure.SConfig.GitOps.Tenant(),
+			infrastructure.SConfig.GitOps.PruneMode,
+			*infrastructure.SConfig.GitOps.AllowEmptyPrune,
+			time.Duration(infrastructure.SConfig.GitOps.PollIntervalSecond)*time.Second,
+		),
+		SecretRotation: newSecretRotationJobHandler(infrastructure.ILogger, infrastructure.ITracer, infrastructure.ICipher, persistence.IUnitOfWork),
 	}
 }
@@ -0,0 +1,110 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"health-check/domain/entities"
+	"health-check/domain/valueObjects"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	multiStepStatusOk     = "OK"
+	multiStepStatusFailed = "STEP_FAILED"
+)
+
+type stepResult struct {
+	Name       string `json:"name"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	Duration   int64  `json:"duration"`
+	Error      string `json:"error,omitempty"`
+}
+
+// runSteps runs the steps of a multi step health check in order and stops at the first failing one, which is then the
+// last of the results. header is the response header of the last step that got an answer.
+func (r SHealthCheckJobHandler) runSteps(ctx *contextplus.Context, healthCheck entities.HealthCheck, requestTemplate *sTemplate) (results []stepResult, header http.Header, err error) {
+	base, err := url.Parse(healthCheck.Url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := healthCheck.Settings.Data().MultiStep
+	if settings == nil {
+		return nil, nil, errors.New("multi step health check has no steps")
+	}
+
+	for i, step := range settings.Steps {
+		result := stepResult{Name: step.Name}
+		if result.Name == "" {
+			result.Name = fmt.Sprintf("step %d", i+1)
+		}
+
+		startedAt := time.Now()
+		response, stepVariables, err := r.runStep(ctx, base, step, requestTemplate)
+		result.Duration = time.Since(startedAt).Milliseconds()
+		if response != nil {
+			result.StatusCode = response.StatusCode
+			header = response.Header
+		}
+		if err != nil {
+			result.Error = err.Error()
+			return append(results, result), header, fmt.Errorf("%s: %w", result.Name, err)
+		}
+
+		for name, value := range stepVariables {
+			requestTemplate.variables[name] = value
+		}
+		results = append(results, result)
+	}
+
+	return results, header, nil
+}
+
+// runStep renders the templates of the step with the variables the previous steps extracted and sends it.
+func (r SHealthCheckJobHandler) runStep(ctx *contextplus.Context, base *url.URL, step valueObjects.HttpStep, requestTemplate *sTemplate) (*valueObjects.HttpStepResponse, map[string]string, error) {
+	stepUrl, headers, body, err := requestTemplate.request(step.Url, step.Headers, step.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	target, err := base.Parse(stepUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statusCode, header, responseBody, err := r.iRest.Execute(ctx, step.Method, target.String(), headers, body, requestTemplate.sensitive)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := &valueObjects.HttpStepResponse{StatusCode: statusCode, Header: header, Body: responseBody}
+	if err = step.Check(response); err != nil {
+		return response, nil, err
+	}
+
+	stepVariables, err := step.Variables(response)
+	return response, stepVariables, err
+}
+
+// multiStepProbeBody stores the name, status and latency in milliseconds of every step that ran, along with the step
+// that failed and why.
+func multiStepProbeBody(results []stepResult, err error) string {
+	body := struct {
+		Steps      []stepResult `json:"steps"`
+		FailedStep string       `json:"failedStep,omitempty"`
+		Error      string       `json:"error,omitempty"`
+	}{Steps: results}
+	if err != nil {
+		body.Error = err.Error()
+		if len(results) != 0 {
+			body.FailedStep = results[len(results)-1].Name
+			body.Error = results[len(results)-1].Error
+		}
+	}
+	data, _ := json.Marshal(body)
+	return string(data)
+}
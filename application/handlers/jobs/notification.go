@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+)
+
+func notificationSubject(healthCheck entities.HealthCheck) string {
+	if healthCheck.Name == "" {
+		return fmt.Sprintf("id : %d | url : %s | method : %s", healthCheck.Id, healthCheck.Url, healthCheck.Method)
+	}
+	if healthCheck.Owner == "" {
+		return healthCheck.Name
+	}
+	return fmt.Sprintf("%s (%s)", healthCheck.Name, healthCheck.Owner)
+}
+
+func withRunbook(healthCheck entities.HealthCheck, msg string) string {
+	if healthCheck.RunbookUrl == "" {
+		return msg
+	}
+	return fmt.Sprintf("%s | runbook : %s", msg, healthCheck.RunbookUrl)
+}
+
+func enqueueChannelNotification(ctx *contextplus.Context, iUnitOfWork interfaces.IUnitOfWork, notificationChannel entities.NotificationChannel, subject string, msg string) error {
+	notificationDelivery := entities.NewNotificationDelivery(notificationChannel.TenantId, notificationChannel.Provider, notificationChannel.Receiver, subject, msg)
+	return iUnitOfWork.NotificationDeliveryRepository().Create(ctx, &notificationDelivery)
+}
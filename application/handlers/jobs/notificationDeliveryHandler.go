@@ -0,0 +1,169 @@
+package jobs
+
+import (
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/rateLimiter"
+	"health-check/pkg/tracer"
+	"sync"
+	"time"
+)
+
+const (
+	_notificationDeliveryCronKey = "notificationDelivery"
+	_notificationDeliveryLease   = time.Minute
+)
+
+type SNotificationDeliveryJobHandler struct {
+	iLogger       logger.ILogger
+	iTracer       tracer.ITracer
+	iCron         interfaces.ICron
+	iNotification interfaces.INotification
+	iUnitOfWork   interfaces.IUnitOfWork
+
+	pollInterval time.Duration
+	batchSize    uint
+	maxAttempts  uint
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+	rateLimiters map[enums.NotificationProvider]rateLimiter.IRateLimiter
+	running      *sync.Mutex
+
+	callDeliver func(ctx *contextplus.Context)
+	callSend    func(ctx *contextplus.Context, notificationDelivery entities.NotificationDelivery)
+}
+
+func newNotificationDeliveryJobHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iCron interfaces.ICron,
+	iNotification interfaces.INotification,
+	iUnitOfWork interfaces.IUnitOfWork,
+	pollInterval time.Duration,
+	batchSize uint,
+	maxAttempts uint,
+	baseBackoff time.Duration,
+	maxBackoff time.Duration,
+	rateLimitPerMinute map[enums.NotificationProvider]uint,
+) SNotificationDeliveryJobHandler {
+	s := SNotificationDeliveryJobHandler{
+		iLogger:       iLogger,
+		iTracer:       iTracer,
+		iCron:         iCron,
+		iNotification: iNotification,
+		iUnitOfWork:   iUnitOfWork,
+		pollInterval:  pollInterval,
+		batchSize:     batchSize,
+		maxAttempts:   maxAttempts,
+		baseBackoff:   baseBackoff,
+		maxBackoff:    maxBackoff,
+		rateLimiters:  make(map[enums.NotificationProvider]rateLimiter.IRateLimiter, len(rateLimitPerMinute)),
+		running:       new(sync.Mutex),
+	}
+	for provider, limit := range rateLimitPerMinute {
+		s.rateLimiters[provider] = rateLimiter.NewRateLimiter(limit, time.Minute)
+	}
+	s.callDeliver = s.deliver
+	s.callSend = s.send
+	return s
+}
+
+func (r SNotificationDeliveryJobHandler) Start(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := r.iCron.AddFunc(_notificationDeliveryCronKey, fmt.Sprintf("@every %s", r.pollInterval), func() {
+		r.callDeliver(ctx)
+	}); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in add notification delivery job")
+
+		return err
+	}
+
+	return nil
+}
+
+func (r SNotificationDeliveryJobHandler) Stop(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	r.iCron.RemoveFunc(_notificationDeliveryCronKey)
+
+	return nil
+}
+
+func (r SNotificationDeliveryJobHandler) deliver(ctx *contextplus.Context) {
+	if !r.running.TryLock() {
+		return
+	}
+	defer r.running.Unlock()
+
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	notificationDeliveries, err := r.iUnitOfWork.NotificationDeliveryRepository().Claim(ctx, r.batchSize, _notificationDeliveryLease)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in claim notification deliveries")
+
+		return
+	}
+
+	for _, notificationDelivery := range notificationDeliveries {
+		r.callSend(ctx, notificationDelivery)
+	}
+}
+
+func (r SNotificationDeliveryJobHandler) send(ctx *contextplus.Context, notificationDelivery entities.NotificationDelivery) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if limiter, ok := r.rateLimiters[notificationDelivery.Provider]; ok {
+		if allowed, next := limiter.Allow(time.Now()); !allowed {
+			notificationDelivery.Postpone(next)
+			r.save(ctx, notificationDelivery)
+			return
+		}
+	}
+
+	if err := r.iNotification.Send(
+		ctx,
+		notificationDelivery.Provider,
+		notificationDelivery.Receiver,
+		notificationDelivery.Subject,
+		notificationDelivery.Message,
+	); err != nil {
+		notificationDelivery.MarkFailed(err, r.maxAttempts, r.backoff(notificationDelivery.Attempts))
+		r.iLogger.WithError(err).WithUint("id", notificationDelivery.Id).WithUint("attempts", notificationDelivery.Attempts).WithString("status", notificationDelivery.Status.String()).Warn(ctx, "error in deliver notification")
+	} else {
+		notificationDelivery.MarkSent()
+	}
+
+	r.save(ctx, notificationDelivery)
+}
+
+func (r SNotificationDeliveryJobHandler) save(ctx *contextplus.Context, notificationDelivery entities.NotificationDelivery) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := r.iUnitOfWork.NotificationDeliveryRepository().Save(ctx, &notificationDelivery); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("notificationDelivery", notificationDelivery).Error(ctx, "error in save notification delivery")
+	}
+}
+
+func (r SNotificationDeliveryJobHandler) backoff(attempts uint) time.Duration {
+	backoff := r.baseBackoff
+	for i := uint(0); i < attempts && backoff < r.maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.maxBackoff)
+}
@@ -0,0 +1,129 @@
+package jobs
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"testing"
+	"time"
+)
+
+func TestNotificationDeliveryBackoff(t *testing.T) {
+	mock := setup(t)
+	notificationDeliveryJobHandler := newNotificationDeliveryJobHandler(mock.iLogger, mock.iTracer, mock.iCron, mock.iNotification, mock.iUnitOfWork, time.Second, 10, 5, time.Second, time.Minute, nil)
+
+	tableTests := []struct {
+		name     string
+		attempts uint
+		want     time.Duration
+	}{
+		{name: "first retry waits the base backoff", attempts: 0, want: time.Second},
+		{name: "every attempt doubles it", attempts: 3, want: 8 * time.Second},
+		{name: "capped at the max backoff", attempts: 10, want: time.Minute},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			assert.Equal(t, tableTest.want, notificationDeliveryJobHandler.backoff(tableTest.attempts))
+		})
+	}
+}
+
+func TestNotificationDeliverySend(t *testing.T) {
+	tableTests := []struct {
+		name        string
+		attempts    uint
+		sendErr     error
+		rateLimited bool
+		assert      func(t *testing.T, notificationDelivery entities.NotificationDelivery)
+	}{
+		{
+			name: "sent",
+			assert: func(t *testing.T, notificationDelivery entities.NotificationDelivery) {
+				assert.Equal(t, enums.DeliveryStatusSent, notificationDelivery.Status)
+				assert.NotNil(t, notificationDelivery.SentAt)
+			},
+		},
+		{
+			name:    "failed attempt is retried with backoff",
+			sendErr: errors.New("error in send"),
+			assert: func(t *testing.T, notificationDelivery entities.NotificationDelivery) {
+				assert.Equal(t, enums.DeliveryStatusPending, notificationDelivery.Status)
+				assert.Equal(t, uint(1), notificationDelivery.Attempts)
+				assert.Equal(t, "error in send", notificationDelivery.LastError)
+				assert.True(t, notificationDelivery.NextAttemptAt.After(time.Now()))
+			},
+		},
+		{
+			name:     "last failed attempt is dead lettered",
+			attempts: 2,
+			sendErr:  errors.New("error in send"),
+			assert: func(t *testing.T, notificationDelivery entities.NotificationDelivery) {
+				assert.Equal(t, enums.DeliveryStatusDead, notificationDelivery.Status)
+				assert.Equal(t, uint(3), notificationDelivery.Attempts)
+			},
+		},
+		{
+			name:        "rate limited delivery is postponed without sending",
+			rateLimited: true,
+			assert: func(t *testing.T, notificationDelivery entities.NotificationDelivery) {
+				assert.Equal(t, enums.DeliveryStatusPending, notificationDelivery.Status)
+				assert.Equal(t, uint(0), notificationDelivery.Attempts)
+				assert.True(t, notificationDelivery.NextAttemptAt.After(time.Now()))
+			},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mock := setup(t)
+			notificationDeliveryJobHandler := newNotificationDeliveryJobHandler(
+				mock.iLogger,
+				mock.iTracer,
+				mock.iCron,
+				mock.iNotification,
+				mock.iUnitOfWork,
+				time.Second,
+				10,
+				3,
+				time.Second,
+				time.Minute,
+				map[enums.NotificationProvider]uint{enums.NotificationProviderSlack: 1},
+			)
+			ctx := contextplus.Background()
+			notificationDelivery := entities.NewNotificationDelivery([16]byte{}, enums.NotificationProviderSlack, "#alerts", "subject", "message")
+			notificationDelivery.Id = 4
+			notificationDelivery.Attempts = tableTest.attempts
+			if tableTest.rateLimited {
+				notificationDeliveryJobHandler.rateLimiters[enums.NotificationProviderSlack].Allow(time.Now())
+			}
+
+			mock.iTracer.EXPECT().SpanFromContext(ctx).Return(mock.iSpan, ctx).Times(2)
+			mock.iSpan.EXPECT().Finish().Times(2)
+			if !tableTest.rateLimited {
+				mock.iNotification.EXPECT().Send(ctx, enums.NotificationProviderSlack, "#alerts", "subject", "message").Return(tableTest.sendErr).Times(1)
+			}
+			if tableTest.sendErr != nil {
+				mock.iLogger.EXPECT().WithError(tableTest.sendErr).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithUint("id", notificationDelivery.Id).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithUint("attempts", gomock.Any()).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().WithString("status", gomock.Any()).Return(mock.iLogger).Times(1)
+				mock.iLogger.EXPECT().Warn(ctx, "error in deliver notification").Times(1)
+			}
+
+			var saved entities.NotificationDelivery
+			mock.iUnitOfWork.EXPECT().NotificationDeliveryRepository().Return(mock.iNotificationDeliveryRepository).Times(1)
+			mock.iNotificationDeliveryRepository.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, notificationDelivery *entities.NotificationDelivery) (*entities.NotificationDelivery, error) {
+				saved = *notificationDelivery
+				return notificationDelivery, nil
+			}).Times(1)
+
+			notificationDeliveryJobHandler.send(ctx, notificationDelivery)
+
+			tableTest.assert(t, saved)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"testing"
+)
+
+func TestNotificationSubject(t *testing.T) {
+	tableTests := []struct {
+		name        string
+		healthCheck entities.HealthCheck
+		want        string
+	}{
+		{
+			name:        "unnamed health check",
+			healthCheck: entities.HealthCheck{Id: 1, Url: "https://api.internal", Method: enums.HttpMethodGET},
+			want:        "id : 1 | url : https://api.internal | method : GET",
+		},
+		{
+			name:        "named health check",
+			healthCheck: entities.HealthCheck{Id: 1, Name: "api"},
+			want:        "api",
+		},
+		{
+			name:        "named health check with an owner",
+			healthCheck: entities.HealthCheck{Id: 1, Name: "api", Owner: "platform"},
+			want:        "api (platform)",
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			assert.Equal(t, tableTest.want, notificationSubject(tableTest.healthCheck))
+		})
+	}
+}
+
+func TestWithRunbook(t *testing.T) {
+	tableTests := []struct {
+		name        string
+		healthCheck entities.HealthCheck
+		want        string
+	}{
+		{
+			name: "without a runbook",
+			want: "incident id : 1",
+		},
+		{
+			name:        "with a runbook",
+			healthCheck: entities.HealthCheck{RunbookUrl: "https://wiki.internal/api"},
+			want:        "incident id : 1 | runbook : https://wiki.internal/api",
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			assert.Equal(t, tableTest.want, withRunbook(tableTest.healthCheck, "incident id : 1"))
+		})
+	}
+}
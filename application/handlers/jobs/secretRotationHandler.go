@@ -0,0 +1,116 @@
+package jobs
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+// SSecretRotationJobHandler seals again with the current encryption key every value still sealed with a previous one,
+// once the job ran on start the previous keys can be dropped from the config.
+type SSecretRotationJobHandler struct {
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+	iCipher     interfaces.ICipher
+	iUnitOfWork interfaces.IUnitOfWork
+}
+
+func newSecretRotationJobHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iCipher interfaces.ICipher,
+	iUnitOfWork interfaces.IUnitOfWork,
+) SSecretRotationJobHandler {
+	return SSecretRotationJobHandler{
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+		iCipher:     iCipher,
+		iUnitOfWork: iUnitOfWork,
+	}
+}
+
+// Start rotates the secrets of the secret store and the credentials of the health check settings, a value no key opens
+// is logged and left as is so one bad value does not hold the service back.
+func (r SSecretRotationJobHandler) Start(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	secrets, err := r.iUnitOfWork.SecretRepository().All(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in get secrets")
+
+		return err
+	}
+
+	for _, secret := range secrets {
+		value, err := r.iCipher.Rotate(secret.TenantId, entities.SecretField(secret.Name), secret.Value)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("secretId", secret.Id).Error(ctx, "error in rotate secret")
+
+			continue
+		}
+
+		if value == secret.Value {
+			continue
+		}
+
+		if _, err = r.iUnitOfWork.SecretRepository().UpdateColumn(ctx, "value", value, genericRepository.Equal("id", secret.Id)); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("secretId", secret.Id).Error(ctx, "error in update rotated secret")
+		}
+	}
+
+	healthChecks, err := r.iUnitOfWork.HealthCheckRepository().All(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in get health checks")
+
+		return err
+	}
+
+	for _, healthCheck := range healthChecks {
+		rotated := false
+		settings, err := healthCheck.Settings.Data().Seal(healthCheck.TenantId, func(tenantId uuid.UUID, field string, value string) (string, error) {
+			result, err := r.iCipher.Rotate(tenantId, field, value)
+			rotated = rotated || result != value
+			return result, err
+		})
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in rotate health check credentials")
+
+			continue
+		}
+
+		if !rotated {
+			continue
+		}
+
+		if _, err = r.iUnitOfWork.HealthCheckRepository().UpdateColumn(ctx, "settings", datatypes.NewJSONType(settings), genericRepository.Equal("id", healthCheck.Id)); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("err", err)
+			r.iLogger.WithError(err).WithUint("healthCheckId", healthCheck.Id).Error(ctx, "error in update rotated health check credentials")
+		}
+	}
+
+	return nil
+}
+
+func (r SSecretRotationJobHandler) Stop(ctx *contextplus.Context) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
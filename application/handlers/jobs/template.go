@@ -0,0 +1,212 @@
+package jobs
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"hash"
+	"health-check/domain/valueObjects"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// sTemplate expands the templates of a health check at probe time. It remembers every secret and environment value it
+// expanded so the stored result and the logs can mask them.
+type sTemplate struct {
+	envPrefix string
+	secrets   map[string]string
+	lookup    secretLookup
+	variables map[string]string
+	sensitive valueObjects.Sensitive
+	literal   bool
+
+	body         string
+	bodyRendered bool
+}
+
+// secretLookup opens a secret of the secret store by name, found is false when the tenant has no such secret.
+type secretLookup func(name string) (value string, found bool, err error)
+
+// newTemplate reads the secrets of the health check first and falls back to lookup, which may be nil, for the others.
+func newTemplate(envPrefix string, secrets map[string]string, lookup secretLookup) *sTemplate {
+	opened := make(map[string]string, len(secrets))
+	for name, value := range secrets {
+		opened[name] = value
+	}
+	return &sTemplate{
+		envPrefix: envPrefix,
+		secrets:   opened,
+		lookup:    lookup,
+		variables: map[string]string{},
+	}
+}
+
+// request renders the body first so the url and the headers can sign it with {{ hmac "sha256" (secret "key") body }}.
+func (r *sTemplate) request(url string, headers map[string]string, body map[string]any) (string, map[string]string, map[string]any, error) {
+	r.bodyRendered = false
+
+	rendered, err := r.value(body)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	renderedBody, _ := rendered.(map[string]any)
+
+	data, err := json.Marshal(renderedBody)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	r.body, r.bodyRendered = string(data), true
+
+	if url, err = r.render(url); err != nil {
+		return "", nil, nil, err
+	}
+
+	renderedHeaders := make(map[string]string, len(headers))
+	for key, value := range headers {
+		if renderedHeaders[key], err = r.render(value); err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	return url, renderedHeaders, renderedBody, nil
+}
+
+func (r *sTemplate) value(value any) (any, error) {
+	switch value := value.(type) {
+	case string:
+		return r.render(value)
+	case map[string]any:
+		if value == nil {
+			return value, nil
+		}
+		result := make(map[string]any, len(value))
+		for key, item := range value {
+			rendered, err := r.value(item)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = rendered
+		}
+		return result, nil
+	case []any:
+		result := make([]any, 0, len(value))
+		for _, item := range value {
+			rendered, err := r.value(item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, rendered)
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+// render expands a single template, a variable no previous step extracted fails it. A literal template returns the
+// text as is.
+func (r *sTemplate) render(text string) (string, error) {
+	if r.literal || !strings.Contains(text, "{{") {
+		return text, nil
+	}
+
+	parsed, err := template.New("request").Option("missingkey=error").Funcs(template.FuncMap{
+		"env":       r.env,
+		"secret":    r.secret,
+		"body":      r.requestBody,
+		"now":       func() time.Time { return time.Now().UTC() },
+		"timestamp": func() int64 { return time.Now().Unix() },
+		"uuid":      uuid.NewString,
+		"hmac":      hmacHex,
+	}).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	if err = parsed.Execute(&builder, r.variables); err != nil {
+		return "", err
+	}
+	return builder.String(), nil
+}
+
+// env reads an environment variable of the monitoring host, only the ones with the configured prefix so the
+// templates cannot read the credentials of the service itself.
+func (r *sTemplate) env(name string) (string, error) {
+	if !strings.HasPrefix(name, r.envPrefix) {
+		return "", fmt.Errorf("environment variable %s does not have the %s prefix", name, r.envPrefix)
+	}
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	r.addSensitive(value)
+	return value, nil
+}
+
+func (r *sTemplate) secret(name string) (string, error) {
+	value, ok := r.secrets[name]
+	if !ok && r.lookup != nil {
+		var err error
+		if value, ok, err = r.lookup(name); err != nil {
+			return "", fmt.Errorf("secret %s can not be read: %w", name, err)
+		}
+		if ok {
+			r.secrets[name] = value
+		}
+	}
+	if !ok {
+		return "", fmt.Errorf("secret %s is not defined", name)
+	}
+	r.addSensitive(value)
+	return value, nil
+}
+
+func (r *sTemplate) requestBody() (string, error) {
+	if !r.bodyRendered {
+		return "", errors.New("body can not be used inside the body")
+	}
+	return r.body, nil
+}
+
+func (r *sTemplate) addSensitive(value string) {
+	if value != "" && !slices.Contains(r.sensitive, value) {
+		r.sensitive = append(r.sensitive, value)
+	}
+}
+
+// mask hides the expanded secrets in an error about to be logged or stored.
+func (r *sTemplate) mask(err error) error {
+	if err == nil || len(r.sensitive) == 0 {
+		return err
+	}
+	return errors.New(r.sensitive.Mask(err.Error()))
+}
+
+// hmacHex signs the message with the key and returns the hex digest, algorithm is sha1, sha256 or sha512.
+func hmacHex(algorithm string, key string, message string) (string, error) {
+	var newHash func() hash.Hash
+	switch algorithm {
+	case "sha1":
+		newHash = sha1.New
+	case "sha256":
+		newHash = sha256.New
+	case "sha512":
+		newHash = sha512.New
+	default:
+		return "", fmt.Errorf("hmac algorithm %s is not supported", algorithm)
+	}
+
+	mac := hmac.New(newHash, []byte(key))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
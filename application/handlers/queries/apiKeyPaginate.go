@@ -0,0 +1,18 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"health-check/application/common"
+)
+
+type SApiKeyPaginateQuery struct {
+	tenantId      uuid.UUID
+	paginateQuery common.PaginateQuery
+}
+
+func NewApiKeyPaginateQuery(tenantId uuid.UUID, paginateQuery common.PaginateQuery) SApiKeyPaginateQuery {
+	return SApiKeyPaginateQuery{
+		tenantId:      tenantId,
+		paginateQuery: paginateQuery,
+	}
+}
@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SApiKeyPaginateQueryHandler struct {
+	iLogger           logger.ILogger
+	iTracer           tracer.ITracer
+	iApiKeyRepository interfaces.IApiKeyRepository
+}
+
+func newApiKeyPaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iApiKeyRepository interfaces.IApiKeyRepository,
+) SApiKeyPaginateQueryHandler {
+	return SApiKeyPaginateQueryHandler{
+		iLogger:           iLogger,
+		iTracer:           iTracer,
+		iApiKeyRepository: iApiKeyRepository,
+	}
+}
+
+func (r SApiKeyPaginateQueryHandler) Handle(ctx *contextplus.Context, query SApiKeyPaginateQuery) (*common.PaginateResult[entities.ApiKey], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	totalRows, apiKeys, err := r.iApiKeyRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		genericRepository.Equal("tenant_id", query.tenantId),
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate api keys")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(apiKeys, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
@@ -0,0 +1,29 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"health-check/application/common"
+	"time"
+)
+
+type SAuditLogPaginateQuery struct {
+	tenantId      uuid.UUID
+	paginateQuery common.PaginateQuery
+	actorId       *uuid.UUID
+	entity        *string
+	entityId      *uint
+	from          *time.Time
+	to            *time.Time
+}
+
+func NewAuditLogPaginateQuery(tenantId uuid.UUID, paginateQuery common.PaginateQuery, actorId *uuid.UUID, entity *string, entityId *uint, from *time.Time, to *time.Time) SAuditLogPaginateQuery {
+	return SAuditLogPaginateQuery{
+		tenantId:      tenantId,
+		paginateQuery: paginateQuery,
+		actorId:       actorId,
+		entity:        entity,
+		entityId:      entityId,
+		from:          from,
+		to:            to,
+	}
+}
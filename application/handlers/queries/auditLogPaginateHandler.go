@@ -0,0 +1,75 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SAuditLogPaginateQueryHandler struct {
+	iLogger             logger.ILogger
+	iTracer             tracer.ITracer
+	iAuditLogRepository interfaces.IAuditLogRepository
+}
+
+func newAuditLogPaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iAuditLogRepository interfaces.IAuditLogRepository,
+) SAuditLogPaginateQueryHandler {
+	return SAuditLogPaginateQueryHandler{
+		iLogger:             iLogger,
+		iTracer:             iTracer,
+		iAuditLogRepository: iAuditLogRepository,
+	}
+}
+
+func (r SAuditLogPaginateQueryHandler) Handle(ctx *contextplus.Context, query SAuditLogPaginateQuery) (*common.PaginateResult[entities.AuditLog], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	specifications := []genericRepository.Specification{
+		genericRepository.Equal("tenant_id", query.tenantId),
+	}
+	if query.actorId != nil {
+		specifications = append(specifications, genericRepository.Equal("actor_id", *query.actorId))
+	}
+	if query.entity != nil {
+		specifications = append(specifications, genericRepository.Equal("entity", *query.entity))
+	}
+	if query.entityId != nil {
+		specifications = append(specifications, genericRepository.Equal("entity_id", *query.entityId))
+	}
+	if query.from != nil {
+		specifications = append(specifications, genericRepository.GreaterOrEqual("created_at", *query.from))
+	}
+	if query.to != nil {
+		specifications = append(specifications, genericRepository.LessThan("created_at", *query.to))
+	}
+
+	totalRows, auditLogs, err := r.iAuditLogRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		specifications...,
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate audit logs")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(auditLogs, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
@@ -0,0 +1,18 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"health-check/application/common"
+)
+
+type SDigestPaginateQuery struct {
+	tenantId      uuid.UUID
+	paginateQuery common.PaginateQuery
+}
+
+func NewDigestPaginateQuery(tenantId uuid.UUID, paginateQuery common.PaginateQuery) SDigestPaginateQuery {
+	return SDigestPaginateQuery{
+		tenantId:      tenantId,
+		paginateQuery: paginateQuery,
+	}
+}
@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SDigestPaginateQueryHandler struct {
+	iLogger           logger.ILogger
+	iTracer           tracer.ITracer
+	iDigestRepository interfaces.IDigestRepository
+}
+
+func newDigestPaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iDigestRepository interfaces.IDigestRepository,
+) SDigestPaginateQueryHandler {
+	return SDigestPaginateQueryHandler{
+		iLogger:           iLogger,
+		iTracer:           iTracer,
+		iDigestRepository: iDigestRepository,
+	}
+}
+
+func (r SDigestPaginateQueryHandler) Handle(ctx *contextplus.Context, query SDigestPaginateQuery) (*common.PaginateResult[entities.Digest], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	totalRows, digests, err := r.iDigestRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		genericRepository.Equal("tenant_id", query.tenantId),
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate digests")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(digests, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
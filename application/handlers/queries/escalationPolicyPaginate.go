@@ -0,0 +1,18 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"health-check/application/common"
+)
+
+type SEscalationPolicyPaginateQuery struct {
+	tenantId      uuid.UUID
+	paginateQuery common.PaginateQuery
+}
+
+func NewEscalationPolicyPaginateQuery(tenantId uuid.UUID, paginateQuery common.PaginateQuery) SEscalationPolicyPaginateQuery {
+	return SEscalationPolicyPaginateQuery{
+		tenantId:      tenantId,
+		paginateQuery: paginateQuery,
+	}
+}
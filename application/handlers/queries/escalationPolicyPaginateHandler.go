@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SEscalationPolicyPaginateQueryHandler struct {
+	iLogger                     logger.ILogger
+	iTracer                     tracer.ITracer
+	iEscalationPolicyRepository interfaces.IEscalationPolicyRepository
+}
+
+func newEscalationPolicyPaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iEscalationPolicyRepository interfaces.IEscalationPolicyRepository,
+) SEscalationPolicyPaginateQueryHandler {
+	return SEscalationPolicyPaginateQueryHandler{
+		iLogger:                     iLogger,
+		iTracer:                     iTracer,
+		iEscalationPolicyRepository: iEscalationPolicyRepository,
+	}
+}
+
+func (r SEscalationPolicyPaginateQueryHandler) Handle(ctx *contextplus.Context, query SEscalationPolicyPaginateQuery) (*common.PaginateResult[entities.EscalationPolicy], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	totalRows, escalationPolicies, err := r.iEscalationPolicyRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		genericRepository.Equal("tenant_id", query.tenantId),
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate escalation policies")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(escalationPolicies, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
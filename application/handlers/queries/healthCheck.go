@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"health-check/application/interfaces"
+	"health-check/pkg/genericRepository"
+)
+
+func healthCheckSpecifications(ctx *contextplus.Context, iTagRepository interfaces.ITagRepository, iHealthCheckLabelRepository interfaces.IHealthCheckLabelRepository, tenantId uuid.UUID, search string, tags []string, labels map[string]string) ([]genericRepository.Specification, error) {
+	specifications := []genericRepository.Specification{
+		genericRepository.Equal("tenant_id", tenantId),
+	}
+
+	if search != "" {
+		pattern := "%" + search + "%"
+		specifications = append(specifications, genericRepository.Or(
+			genericRepository.ILike("name", pattern),
+			genericRepository.ILike("description", pattern),
+			genericRepository.ILike("owner", pattern),
+			genericRepository.ILike("url", pattern),
+		))
+	}
+
+	if len(tags) > 0 {
+		healthCheckIds, err := iTagRepository.HealthCheckIds(ctx, tenantId, tags)
+		if err != nil {
+			return nil, err
+		}
+		specifications = append(specifications, genericRepository.In("id", healthCheckIds...))
+	}
+
+	if len(labels) > 0 {
+		healthCheckIds, err := iHealthCheckLabelRepository.HealthCheckIds(ctx, labels)
+		if err != nil {
+			return nil, err
+		}
+		specifications = append(specifications, genericRepository.In("id", healthCheckIds...))
+	}
+
+	return specifications, nil
+}
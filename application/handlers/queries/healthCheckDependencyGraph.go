@@ -0,0 +1,13 @@
+package queries
+
+import "github.com/google/uuid"
+
+type SHealthCheckDependencyGraphQuery struct {
+	tenantId uuid.UUID
+}
+
+func NewHealthCheckDependencyGraphQuery(tenantId uuid.UUID) SHealthCheckDependencyGraphQuery {
+	return SHealthCheckDependencyGraphQuery{
+		tenantId: tenantId,
+	}
+}
@@ -0,0 +1,116 @@
+package queries
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SHealthCheckDependencyGraphQueryHandler struct {
+	iLogger                          logger.ILogger
+	iTracer                          tracer.ITracer
+	iHealthCheckRepository           interfaces.IHealthCheckRepository
+	iHealthCheckDependencyRepository interfaces.IHealthCheckDependencyRepository
+	iIncidentRepository              interfaces.IIncidentRepository
+}
+
+func newHealthCheckDependencyGraphQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iHealthCheckRepository interfaces.IHealthCheckRepository,
+	iHealthCheckDependencyRepository interfaces.IHealthCheckDependencyRepository,
+	iIncidentRepository interfaces.IIncidentRepository,
+) SHealthCheckDependencyGraphQueryHandler {
+	return SHealthCheckDependencyGraphQueryHandler{
+		iLogger:                          iLogger,
+		iTracer:                          iTracer,
+		iHealthCheckRepository:           iHealthCheckRepository,
+		iHealthCheckDependencyRepository: iHealthCheckDependencyRepository,
+		iIncidentRepository:              iIncidentRepository,
+	}
+}
+
+func (r SHealthCheckDependencyGraphQueryHandler) Handle(ctx *contextplus.Context, query SHealthCheckDependencyGraphQuery) (*valueObjects.DependencyGraph, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	healthCheckDependencies, err := r.iHealthCheckDependencyRepository.All(ctx, genericRepository.Equal("tenant_id", query.tenantId))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in get all health check dependencies")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	dependencyGraph := &valueObjects.DependencyGraph{
+		Nodes: []valueObjects.DependencyNode{},
+		Edges: []valueObjects.DependencyEdge{},
+	}
+	if len(healthCheckDependencies) == 0 {
+		return dependencyGraph, nil
+	}
+
+	var healthCheckIds []uint
+	for _, dependency := range healthCheckDependencies {
+		healthCheckIds = append(healthCheckIds, dependency.HealthCheckId, dependency.ParentId)
+	}
+
+	healthChecks, err := r.iHealthCheckRepository.All(ctx, genericRepository.In("id", healthCheckIds...), genericRepository.Equal("tenant_id", query.tenantId))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in get dependency graph health checks")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	incidents, err := r.iIncidentRepository.All(
+		ctx,
+		genericRepository.In("health_check_id", healthCheckIds...),
+		genericRepository.Equal("tenant_id", query.tenantId),
+		genericRepository.NotEqual("status", enums.IncidentStatusResolved),
+	)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).Error(ctx, "error in get dependency graph incidents")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	nodes := make(map[uint]int, len(healthChecks))
+	for _, healthCheck := range healthChecks {
+		nodes[healthCheck.Id] = len(dependencyGraph.Nodes)
+		dependencyGraph.Nodes = append(dependencyGraph.Nodes, valueObjects.DependencyNode{
+			HealthCheckId: healthCheck.Id,
+			Url:           healthCheck.Url,
+		})
+	}
+
+	for _, incident := range incidents {
+		if i, ok := nodes[incident.HealthCheckId]; ok {
+			dependencyGraph.Nodes[i].Down = true
+			dependencyGraph.Nodes[i].Unreachable = incident.UnreachableParentId != nil
+		}
+	}
+
+	for _, dependency := range healthCheckDependencies {
+		_, childOk := nodes[dependency.HealthCheckId]
+		_, parentOk := nodes[dependency.ParentId]
+		if childOk && parentOk {
+			dependencyGraph.Edges = append(dependencyGraph.Edges, valueObjects.DependencyEdge{
+				Id:            dependency.Id,
+				HealthCheckId: dependency.HealthCheckId,
+				ParentId:      dependency.ParentId,
+			})
+		}
+	}
+
+	return dependencyGraph, nil
+}
@@ -0,0 +1,19 @@
+package queries
+
+import "github.com/google/uuid"
+
+type SHealthCheckExportQuery struct {
+	tenantId uuid.UUID
+	search   string
+	tags     []string
+	labels   map[string]string
+}
+
+func NewHealthCheckExportQuery(tenantId uuid.UUID, search string, tags []string, labels map[string]string) SHealthCheckExportQuery {
+	return SHealthCheckExportQuery{
+		tenantId: tenantId,
+		search:   search,
+		tags:     tags,
+		labels:   labels,
+	}
+}
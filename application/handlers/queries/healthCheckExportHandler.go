@@ -0,0 +1,87 @@
+package queries
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SHealthCheckExportQueryHandler struct {
+	iLogger                     logger.ILogger
+	iTracer                     tracer.ITracer
+	iHealthCheckRepository      interfaces.IHealthCheckRepository
+	iTagRepository              interfaces.ITagRepository
+	iHealthCheckLabelRepository interfaces.IHealthCheckLabelRepository
+	iEscalationPolicyRepository interfaces.IEscalationPolicyRepository
+}
+
+func newHealthCheckExportQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iHealthCheckRepository interfaces.IHealthCheckRepository,
+	iTagRepository interfaces.ITagRepository,
+	iHealthCheckLabelRepository interfaces.IHealthCheckLabelRepository,
+	iEscalationPolicyRepository interfaces.IEscalationPolicyRepository,
+) SHealthCheckExportQueryHandler {
+	return SHealthCheckExportQueryHandler{
+		iLogger:                     iLogger,
+		iTracer:                     iTracer,
+		iHealthCheckRepository:      iHealthCheckRepository,
+		iTagRepository:              iTagRepository,
+		iHealthCheckLabelRepository: iHealthCheckLabelRepository,
+		iEscalationPolicyRepository: iEscalationPolicyRepository,
+	}
+}
+
+func (r SHealthCheckExportQueryHandler) Handle(ctx *contextplus.Context, query SHealthCheckExportQuery) ([]valueObjects.HealthCheckDefinition, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	specifications, err := healthCheckSpecifications(ctx, r.iTagRepository, r.iHealthCheckLabelRepository, query.tenantId, query.search, query.tags, query.labels)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in find filtered health checks")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	var healthChecks []entities.HealthCheck
+	if healthChecks, err = r.iHealthCheckRepository.AllWithRelations(ctx, specifications...); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in find health checks")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	var escalationPolicies []entities.EscalationPolicy
+	if escalationPolicies, err = r.iEscalationPolicyRepository.All(ctx, genericRepository.Equal("tenant_id", query.tenantId)); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in find escalation policies")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	escalationPolicyNames := make(map[uint]string, len(escalationPolicies))
+	for _, escalationPolicy := range escalationPolicies {
+		escalationPolicyNames[escalationPolicy.Id] = escalationPolicy.Name
+	}
+
+	definitions := make([]valueObjects.HealthCheckDefinition, 0, len(healthChecks))
+	for _, healthCheck := range healthChecks {
+		var escalationPolicy string
+		if healthCheck.EscalationPolicyId != nil {
+			escalationPolicy = escalationPolicyNames[*healthCheck.EscalationPolicyId]
+		}
+		definitions = append(definitions, healthCheck.Definition(escalationPolicy))
+	}
+
+	return definitions, nil
+}
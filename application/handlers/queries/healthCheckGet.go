@@ -0,0 +1,15 @@
+package queries
+
+import "github.com/google/uuid"
+
+type SHealthCheckGetQuery struct {
+	tenantId uuid.UUID
+	id       uint
+}
+
+func NewHealthCheckGetQuery(tenantId uuid.UUID, id uint) SHealthCheckGetQuery {
+	return SHealthCheckGetQuery{
+		tenantId: tenantId,
+		id:       id,
+	}
+}
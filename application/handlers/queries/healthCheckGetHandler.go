@@ -0,0 +1,45 @@
+package queries
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SHealthCheckGetQueryHandler struct {
+	iLogger                logger.ILogger
+	iTracer                tracer.ITracer
+	iHealthCheckRepository interfaces.IHealthCheckRepository
+}
+
+func newHealthCheckGetQueryHandler(iLogger logger.ILogger, iTracer tracer.ITracer, iHealthCheckRepository interfaces.IHealthCheckRepository) SHealthCheckGetQueryHandler {
+	return SHealthCheckGetQueryHandler{
+		iLogger:                iLogger,
+		iTracer:                iTracer,
+		iHealthCheckRepository: iHealthCheckRepository,
+	}
+}
+
+func (r SHealthCheckGetQueryHandler) Handle(ctx *contextplus.Context, query SHealthCheckGetQuery) (*entities.HealthCheck, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	healthChecks, err := r.iHealthCheckRepository.AllWithRelations(ctx, genericRepository.Equal("id", query.id), genericRepository.Equal("tenant_id", query.tenantId))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in find health check")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	if len(healthChecks) == 0 {
+		return nil, common.ErrorNotFound
+	}
+
+	return &healthChecks[0], nil
+}
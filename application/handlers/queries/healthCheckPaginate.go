@@ -1,15 +1,24 @@
 package queries
 
 import (
+	"github.com/google/uuid"
 	"health-check/application/common"
 )
 
 type SHealthCheckPaginateQuery struct {
+	tenantId      uuid.UUID
 	paginateQuery common.PaginateQuery
+	search        string
+	tags          []string
+	labels        map[string]string
 }
 
-func NewHealthCheckPaginateQuery(paginateQuery common.PaginateQuery) SHealthCheckPaginateQuery {
+func NewHealthCheckPaginateQuery(tenantId uuid.UUID, paginateQuery common.PaginateQuery, search string, tags []string, labels map[string]string) SHealthCheckPaginateQuery {
 	return SHealthCheckPaginateQuery{
+		tenantId:      tenantId,
 		paginateQuery: paginateQuery,
+		search:        search,
+		tags:          tags,
+		labels:        labels,
 	}
 }
@@ -1,29 +1,37 @@
 package queries
 
 import (
+	"errors"
 	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-logger"
 	"health-check/application/common"
 	"health-check/application/interfaces"
 	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
 	"health-check/pkg/tracer"
 )
 
 type SHealthCheckPaginateQueryHandler struct {
-	iLogger                logger.ILogger
-	iTracer                tracer.ITracer
-	iHealthCheckRepository interfaces.IHealthCheckRepository
+	iLogger                     logger.ILogger
+	iTracer                     tracer.ITracer
+	iHealthCheckRepository      interfaces.IHealthCheckRepository
+	iTagRepository              interfaces.ITagRepository
+	iHealthCheckLabelRepository interfaces.IHealthCheckLabelRepository
 }
 
 func newHealthCheckPaginateQueryHandler(
 	iLogger logger.ILogger,
 	iTracer tracer.ITracer,
 	iHealthCheckRepository interfaces.IHealthCheckRepository,
+	iTagRepository interfaces.ITagRepository,
+	iHealthCheckLabelRepository interfaces.IHealthCheckLabelRepository,
 ) SHealthCheckPaginateQueryHandler {
 	return SHealthCheckPaginateQueryHandler{
-		iLogger:                iLogger,
-		iTracer:                iTracer,
-		iHealthCheckRepository: iHealthCheckRepository,
+		iLogger:                     iLogger,
+		iTracer:                     iTracer,
+		iHealthCheckRepository:      iHealthCheckRepository,
+		iTagRepository:              iTagRepository,
+		iHealthCheckLabelRepository: iHealthCheckLabelRepository,
 	}
 }
 
@@ -31,10 +39,26 @@ func (r SHealthCheckPaginateQueryHandler) Handle(ctx *contextplus.Context, query
 	span, ctx := r.iTracer.SpanFromContext(ctx)
 	defer span.Finish()
 
+	specifications, err := healthCheckSpecifications(ctx, r.iTagRepository, r.iHealthCheckLabelRepository, query.tenantId, query.search, query.tags, query.labels)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in find filtered health checks")
+
+		return nil, common.ErrorInternalServer
+	}
+
 	totalRows, healthChecks, err := r.iHealthCheckRepository.Paginate(
 		ctx,
 		query.paginateQuery,
+		specifications...,
 	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("err", err)
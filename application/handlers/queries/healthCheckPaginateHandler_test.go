@@ -0,0 +1,118 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"testing"
+)
+
+func TestHealthCheckPaginateHandle(t *testing.T) {
+	tenantId := uuid.New()
+	paginateQuery := common.PaginateQuery{Page: 1, PerPage: 10}
+	tagErr := errors.New("tag lookup failed")
+
+	tableTests := []struct {
+		name           string
+		tags           []string
+		labels         map[string]string
+		tagIds         []uint
+		tagErr         error
+		labelIds       []uint
+		specifications []genericRepository.Specification
+		err            error
+	}{
+		{
+			name:           "without filters",
+			specifications: []genericRepository.Specification{genericRepository.Equal("tenant_id", tenantId)},
+		},
+		{
+			name:   "tags of the tenant",
+			tags:   []string{"payments", "api"},
+			tagIds: []uint{1, 2},
+			specifications: []genericRepository.Specification{
+				genericRepository.Equal("tenant_id", tenantId),
+				genericRepository.In("id", uint(1), uint(2)),
+			},
+		},
+		{
+			name:     "label selector",
+			labels:   map[string]string{"team": "core"},
+			labelIds: []uint{3},
+			specifications: []genericRepository.Specification{
+				genericRepository.Equal("tenant_id", tenantId),
+				genericRepository.In("id", uint(3)),
+			},
+		},
+		{
+			name:     "tags and label selector both apply",
+			tags:     []string{"payments"},
+			labels:   map[string]string{"team": "core"},
+			tagIds:   []uint{1, 3},
+			labelIds: []uint{3},
+			specifications: []genericRepository.Specification{
+				genericRepository.Equal("tenant_id", tenantId),
+				genericRepository.In("id", uint(1), uint(3)),
+				genericRepository.In("id", uint(3)),
+			},
+		},
+		{
+			name:   "tag lookup fails",
+			tags:   []string{"payments"},
+			tagErr: tagErr,
+			err:    common.ErrorInternalServer,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			iLogger := logger.NewMockILogger(mockController)
+			iTracer := tracer.NewMockITracer(mockController)
+			iSpan := tracer.NewMockISpan(mockController)
+			iHealthCheckRepository := interfaces.NewMockIHealthCheckRepository(mockController)
+			iTagRepository := interfaces.NewMockITagRepository(mockController)
+			iHealthCheckLabelRepository := interfaces.NewMockIHealthCheckLabelRepository(mockController)
+			healthCheckPaginateQueryHandler := newHealthCheckPaginateQueryHandler(iLogger, iTracer, iHealthCheckRepository, iTagRepository, iHealthCheckLabelRepository)
+			ctx := contextplus.Background()
+			query := NewHealthCheckPaginateQuery(tenantId, paginateQuery, "", tableTest.tags, tableTest.labels)
+
+			iTracer.EXPECT().SpanFromContext(ctx).Return(iSpan, ctx).Times(1)
+			iSpan.EXPECT().Finish().Times(1)
+			if len(tableTest.tags) > 0 {
+				iTagRepository.EXPECT().HealthCheckIds(ctx, tenantId, tableTest.tags).Return(tableTest.tagIds, tableTest.tagErr).Times(1)
+			}
+			if len(tableTest.labels) > 0 {
+				iHealthCheckLabelRepository.EXPECT().HealthCheckIds(ctx, tableTest.labels).Return(tableTest.labelIds, nil).Times(1)
+			}
+			if tableTest.err == nil {
+				specifications := make([]any, 0, len(tableTest.specifications))
+				for _, specification := range tableTest.specifications {
+					specifications = append(specifications, specification)
+				}
+				iHealthCheckRepository.EXPECT().Paginate(ctx, paginateQuery, specifications...).Return(int64(0), nil, nil).Times(1)
+			} else {
+				iSpan.EXPECT().SetTag("error", true).Times(1)
+				iSpan.EXPECT().LogKV("err", tableTest.tagErr).Times(1)
+				iLogger.EXPECT().WithError(tableTest.tagErr).Return(iLogger).Times(1)
+				iLogger.EXPECT().WithAny("query", query).Return(iLogger).Times(1)
+				iLogger.EXPECT().Error(ctx, "error in find filtered health checks").Times(1)
+			}
+
+			result, err := healthCheckPaginateQueryHandler.Handle(ctx, query)
+
+			assert.Equal(t, tableTest.err, err)
+			if tableTest.err != nil {
+				return
+			}
+			assert.Equal(t, uint64(0), result.TotalItems)
+		})
+	}
+}
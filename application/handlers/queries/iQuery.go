@@ -4,6 +4,8 @@ import (
 	"github.com/ehsandavari/go-context-plus"
 	"health-check/application/common"
 	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
 	"health-check/infrastructure"
 	"health-check/persistence"
 )
@@ -13,11 +15,39 @@ type IQuery[T1 any, T2 any] interface {
 }
 
 type Queries struct {
-	HealthCheckPaginate IQuery[SHealthCheckPaginateQuery, *common.PaginateResult[entities.HealthCheck]]
+	HealthCheckPaginate          IQuery[SHealthCheckPaginateQuery, *common.PaginateResult[entities.HealthCheck]]
+	HealthCheckGet               IQuery[SHealthCheckGetQuery, *entities.HealthCheck]
+	HealthCheckExport            IQuery[SHealthCheckExportQuery, []valueObjects.HealthCheckDefinition]
+	HealthCheckDependencyGraph   IQuery[SHealthCheckDependencyGraphQuery, *valueObjects.DependencyGraph]
+	NotificationDeliveryPaginate IQuery[SNotificationDeliveryPaginateQuery, *common.PaginateResult[entities.NotificationDelivery]]
+	NotificationChannelPaginate  IQuery[SNotificationChannelPaginateQuery, *common.PaginateResult[entities.NotificationChannel]]
+	EscalationPolicyPaginate     IQuery[SEscalationPolicyPaginateQuery, *common.PaginateResult[entities.EscalationPolicy]]
+	IncidentPaginate             IQuery[SIncidentPaginateQuery, *common.PaginateResult[entities.Incident]]
+	SilencePaginate              IQuery[SSilencePaginateQuery, *common.PaginateResult[entities.Silence]]
+	DigestPaginate               IQuery[SDigestPaginateQuery, *common.PaginateResult[entities.Digest]]
+	RoleBindingPaginate          IQuery[SRoleBindingPaginateQuery, *common.PaginateResult[entities.RoleBinding]]
+	UserRole                     IQuery[SUserRoleQuery, enums.Role]
+	ApiKeyPaginate               IQuery[SApiKeyPaginateQuery, *common.PaginateResult[entities.ApiKey]]
+	AuditLogPaginate             IQuery[SAuditLogPaginateQuery, *common.PaginateResult[entities.AuditLog]]
+	SecretPaginate               IQuery[SSecretPaginateQuery, *common.PaginateResult[entities.Secret]]
 }
 
 func NewQueries(infrastructure *infrastructure.Infrastructure, persistence *persistence.Persistence) Queries {
 	return Queries{
-		HealthCheckPaginate: newHealthCheckPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IHealthCheckRepository),
+		HealthCheckPaginate:          newHealthCheckPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IHealthCheckRepository, persistence.ITagRepository, persistence.IHealthCheckLabelRepository),
+		HealthCheckGet:               newHealthCheckGetQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IHealthCheckRepository),
+		HealthCheckExport:            newHealthCheckExportQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IHealthCheckRepository, persistence.ITagRepository, persistence.IHealthCheckLabelRepository, persistence.IEscalationPolicyRepository),
+		HealthCheckDependencyGraph:   newHealthCheckDependencyGraphQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IHealthCheckRepository, persistence.IHealthCheckDependencyRepository, persistence.IIncidentRepository),
+		NotificationDeliveryPaginate: newNotificationDeliveryPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.INotificationDeliveryRepository),
+		NotificationChannelPaginate:  newNotificationChannelPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.INotificationChannelRepository),
+		EscalationPolicyPaginate:     newEscalationPolicyPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IEscalationPolicyRepository),
+		IncidentPaginate:             newIncidentPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IIncidentRepository),
+		SilencePaginate:              newSilencePaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.ISilenceRepository),
+		DigestPaginate:               newDigestPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IDigestRepository),
+		RoleBindingPaginate:          newRoleBindingPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IRoleBindingRepository),
+		UserRole:                     newUserRoleQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IRoleBindingRepository),
+		ApiKeyPaginate:               newApiKeyPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IApiKeyRepository),
+		AuditLogPaginate:             newAuditLogPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.IAuditLogRepository),
+		SecretPaginate:               newSecretPaginateQueryHandler(infrastructure.ILogger, infrastructure.ITracer, persistence.ISecretRepository),
 	}
 }
@@ -0,0 +1,18 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"health-check/application/common"
+)
+
+type SIncidentPaginateQuery struct {
+	tenantId      uuid.UUID
+	paginateQuery common.PaginateQuery
+}
+
+func NewIncidentPaginateQuery(tenantId uuid.UUID, paginateQuery common.PaginateQuery) SIncidentPaginateQuery {
+	return SIncidentPaginateQuery{
+		tenantId:      tenantId,
+		paginateQuery: paginateQuery,
+	}
+}
@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SIncidentPaginateQueryHandler struct {
+	iLogger             logger.ILogger
+	iTracer             tracer.ITracer
+	iIncidentRepository interfaces.IIncidentRepository
+}
+
+func newIncidentPaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iIncidentRepository interfaces.IIncidentRepository,
+) SIncidentPaginateQueryHandler {
+	return SIncidentPaginateQueryHandler{
+		iLogger:             iLogger,
+		iTracer:             iTracer,
+		iIncidentRepository: iIncidentRepository,
+	}
+}
+
+func (r SIncidentPaginateQueryHandler) Handle(ctx *contextplus.Context, query SIncidentPaginateQuery) (*common.PaginateResult[entities.Incident], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	totalRows, incidents, err := r.iIncidentRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		genericRepository.Equal("tenant_id", query.tenantId),
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate incidents")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(incidents, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
@@ -0,0 +1,18 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"health-check/application/common"
+)
+
+type SNotificationChannelPaginateQuery struct {
+	tenantId      uuid.UUID
+	paginateQuery common.PaginateQuery
+}
+
+func NewNotificationChannelPaginateQuery(tenantId uuid.UUID, paginateQuery common.PaginateQuery) SNotificationChannelPaginateQuery {
+	return SNotificationChannelPaginateQuery{
+		tenantId:      tenantId,
+		paginateQuery: paginateQuery,
+	}
+}
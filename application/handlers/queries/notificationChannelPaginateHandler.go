@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SNotificationChannelPaginateQueryHandler struct {
+	iLogger                        logger.ILogger
+	iTracer                        tracer.ITracer
+	iNotificationChannelRepository interfaces.INotificationChannelRepository
+}
+
+func newNotificationChannelPaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iNotificationChannelRepository interfaces.INotificationChannelRepository,
+) SNotificationChannelPaginateQueryHandler {
+	return SNotificationChannelPaginateQueryHandler{
+		iLogger:                        iLogger,
+		iTracer:                        iTracer,
+		iNotificationChannelRepository: iNotificationChannelRepository,
+	}
+}
+
+func (r SNotificationChannelPaginateQueryHandler) Handle(ctx *contextplus.Context, query SNotificationChannelPaginateQuery) (*common.PaginateResult[entities.NotificationChannel], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	totalRows, notificationChannels, err := r.iNotificationChannelRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		genericRepository.Equal("tenant_id", query.tenantId),
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate notification channels")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(notificationChannels, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
@@ -0,0 +1,21 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"health-check/application/common"
+	"health-check/domain/enums"
+)
+
+type SNotificationDeliveryPaginateQuery struct {
+	tenantId      uuid.UUID
+	paginateQuery common.PaginateQuery
+	statuses      []enums.DeliveryStatus
+}
+
+func NewNotificationDeliveryPaginateQuery(tenantId uuid.UUID, paginateQuery common.PaginateQuery, statuses []enums.DeliveryStatus) SNotificationDeliveryPaginateQuery {
+	return SNotificationDeliveryPaginateQuery{
+		tenantId:      tenantId,
+		paginateQuery: paginateQuery,
+		statuses:      statuses,
+	}
+}
@@ -0,0 +1,70 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SNotificationDeliveryPaginateQueryHandler struct {
+	iLogger                         logger.ILogger
+	iTracer                         tracer.ITracer
+	iNotificationDeliveryRepository interfaces.INotificationDeliveryRepository
+}
+
+func newNotificationDeliveryPaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iNotificationDeliveryRepository interfaces.INotificationDeliveryRepository,
+) SNotificationDeliveryPaginateQueryHandler {
+	return SNotificationDeliveryPaginateQueryHandler{
+		iLogger:                         iLogger,
+		iTracer:                         iTracer,
+		iNotificationDeliveryRepository: iNotificationDeliveryRepository,
+	}
+}
+
+func (r SNotificationDeliveryPaginateQueryHandler) Handle(ctx *contextplus.Context, query SNotificationDeliveryPaginateQuery) (*common.PaginateResult[entities.NotificationDelivery], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	// the list is there to find what needs a retry, so without a status it shows the failed and dead-lettered deliveries
+	statusSpecification := genericRepository.Or(
+		genericRepository.Equal("status", enums.DeliveryStatusDead),
+		genericRepository.And(
+			genericRepository.Equal("status", enums.DeliveryStatusPending),
+			genericRepository.GreaterThan("attempts", uint(0)),
+		),
+	)
+	if len(query.statuses) != 0 {
+		statusSpecification = genericRepository.In("status", query.statuses...)
+	}
+
+	totalRows, notificationDeliveries, err := r.iNotificationDeliveryRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		genericRepository.Equal("tenant_id", query.tenantId),
+		statusSpecification,
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate notification deliveries")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(notificationDeliveries, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"testing"
+)
+
+func TestNotificationDeliveryPaginateHandle(t *testing.T) {
+	tenantId := uuid.New()
+	paginateQuery := common.PaginateQuery{Page: 1, PerPage: 10}
+
+	tableTests := []struct {
+		name          string
+		statuses      []enums.DeliveryStatus
+		specification genericRepository.Specification
+	}{
+		{
+			name: "failed and dead deliveries by default",
+			specification: genericRepository.Or(
+				genericRepository.Equal("status", enums.DeliveryStatusDead),
+				genericRepository.And(
+					genericRepository.Equal("status", enums.DeliveryStatusPending),
+					genericRepository.GreaterThan("attempts", uint(0)),
+				),
+			),
+		},
+		{
+			name:          "requested statuses",
+			statuses:      []enums.DeliveryStatus{enums.DeliveryStatusSent},
+			specification: genericRepository.In("status", enums.DeliveryStatusSent),
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			iTracer := tracer.NewMockITracer(mockController)
+			iSpan := tracer.NewMockISpan(mockController)
+			iNotificationDeliveryRepository := interfaces.NewMockINotificationDeliveryRepository(mockController)
+			notificationDeliveryPaginateQueryHandler := newNotificationDeliveryPaginateQueryHandler(nil, iTracer, iNotificationDeliveryRepository)
+			ctx := contextplus.Background()
+
+			iTracer.EXPECT().SpanFromContext(ctx).Return(iSpan, ctx).Times(1)
+			iSpan.EXPECT().Finish().Times(1)
+			iNotificationDeliveryRepository.EXPECT().Paginate(
+				ctx,
+				paginateQuery,
+				genericRepository.Equal("tenant_id", tenantId),
+				tableTest.specification,
+			).Return(int64(0), nil, nil).Times(1)
+
+			result, err := notificationDeliveryPaginateQueryHandler.Handle(ctx, NewNotificationDeliveryPaginateQuery(tenantId, paginateQuery, tableTest.statuses))
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(0), result.TotalItems)
+		})
+	}
+}
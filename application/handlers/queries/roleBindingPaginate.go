@@ -0,0 +1,18 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+	"health-check/application/common"
+)
+
+type SRoleBindingPaginateQuery struct {
+	tenantId      uuid.UUID
+	paginateQuery common.PaginateQuery
+}
+
+func NewRoleBindingPaginateQuery(tenantId uuid.UUID, paginateQuery common.PaginateQuery) SRoleBindingPaginateQuery {
+	return SRoleBindingPaginateQuery{
+		tenantId:      tenantId,
+		paginateQuery: paginateQuery,
+	}
+}
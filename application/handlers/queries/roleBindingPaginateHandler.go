@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SRoleBindingPaginateQueryHandler struct {
+	iLogger                logger.ILogger
+	iTracer                tracer.ITracer
+	iRoleBindingRepository interfaces.IRoleBindingRepository
+}
+
+func newRoleBindingPaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iRoleBindingRepository interfaces.IRoleBindingRepository,
+) SRoleBindingPaginateQueryHandler {
+	return SRoleBindingPaginateQueryHandler{
+		iLogger:                iLogger,
+		iTracer:                iTracer,
+		iRoleBindingRepository: iRoleBindingRepository,
+	}
+}
+
+func (r SRoleBindingPaginateQueryHandler) Handle(ctx *contextplus.Context, query SRoleBindingPaginateQuery) (*common.PaginateResult[entities.RoleBinding], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	totalRows, roleBindings, err := r.iRoleBindingRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		genericRepository.Equal("tenant_id", query.tenantId),
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate role bindings")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(roleBindings, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
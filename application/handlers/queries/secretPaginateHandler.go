@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SSecretPaginateQueryHandler struct {
+	iLogger           logger.ILogger
+	iTracer           tracer.ITracer
+	iSecretRepository interfaces.ISecretRepository
+}
+
+func newSecretPaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iSecretRepository interfaces.ISecretRepository,
+) SSecretPaginateQueryHandler {
+	return SSecretPaginateQueryHandler{
+		iLogger:           iLogger,
+		iTracer:           iTracer,
+		iSecretRepository: iSecretRepository,
+	}
+}
+
+func (r SSecretPaginateQueryHandler) Handle(ctx *contextplus.Context, query SSecretPaginateQuery) (*common.PaginateResult[entities.Secret], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	totalRows, secrets, err := r.iSecretRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		genericRepository.Equal("tenant_id", query.tenantId),
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate secrets")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(secrets, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
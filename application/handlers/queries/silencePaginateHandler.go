@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SSilencePaginateQueryHandler struct {
+	iLogger            logger.ILogger
+	iTracer            tracer.ITracer
+	iSilenceRepository interfaces.ISilenceRepository
+}
+
+func newSilencePaginateQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iSilenceRepository interfaces.ISilenceRepository,
+) SSilencePaginateQueryHandler {
+	return SSilencePaginateQueryHandler{
+		iLogger:            iLogger,
+		iTracer:            iTracer,
+		iSilenceRepository: iSilenceRepository,
+	}
+}
+
+func (r SSilencePaginateQueryHandler) Handle(ctx *contextplus.Context, query SSilencePaginateQuery) (*common.PaginateResult[entities.Silence], error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	totalRows, silences, err := r.iSilenceRepository.Paginate(
+		ctx,
+		query.paginateQuery,
+		genericRepository.Equal("tenant_id", query.tenantId),
+	)
+	if errors.Is(err, genericRepository.ErrorColumnNotAllowed) {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, common.ErrorBadRequest
+	}
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in paginate silences")
+
+		return nil, common.ErrorInternalServer
+	}
+
+	return common.NewPaginateResult(silences, query.paginateQuery.GetPage(), query.paginateQuery.GetPerPage(), uint64(totalRows)), nil
+}
@@ -0,0 +1,15 @@
+package queries
+
+import "github.com/google/uuid"
+
+type SUserRoleQuery struct {
+	tenantId uuid.UUID
+	userId   uuid.UUID
+}
+
+func NewUserRoleQuery(tenantId uuid.UUID, userId uuid.UUID) SUserRoleQuery {
+	return SUserRoleQuery{
+		tenantId: tenantId,
+		userId:   userId,
+	}
+}
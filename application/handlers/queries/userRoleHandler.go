@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/common"
+	"health-check/application/interfaces"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type SUserRoleQueryHandler struct {
+	iLogger                logger.ILogger
+	iTracer                tracer.ITracer
+	iRoleBindingRepository interfaces.IRoleBindingRepository
+}
+
+func newUserRoleQueryHandler(
+	iLogger logger.ILogger,
+	iTracer tracer.ITracer,
+	iRoleBindingRepository interfaces.IRoleBindingRepository,
+) SUserRoleQueryHandler {
+	return SUserRoleQueryHandler{
+		iLogger:                iLogger,
+		iTracer:                iTracer,
+		iRoleBindingRepository: iRoleBindingRepository,
+	}
+}
+
+func (r SUserRoleQueryHandler) Handle(ctx *contextplus.Context, query SUserRoleQuery) (enums.Role, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	// a tenant is the account of its owner, so the owner is the first admin and grants the other roles through bindings
+	if query.userId == query.tenantId {
+		return enums.RoleAdmin, nil
+	}
+
+	roleBinding, err := r.iRoleBindingRepository.FirstOrDefault(
+		ctx,
+		genericRepository.Equal("tenant_id", query.tenantId),
+		genericRepository.Equal("user_id", query.userId),
+	)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("query", query).Error(ctx, "error in find role binding")
+
+		return "", common.ErrorInternalServer
+	}
+
+	if roleBinding == nil {
+		return "", nil
+	}
+
+	return roleBinding.Role, nil
+}
@@ -0,0 +1,68 @@
+package queries
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"testing"
+)
+
+func TestUserRoleHandle(t *testing.T) {
+	tenantId := uuid.New()
+	userId := uuid.New()
+
+	tableTests := []struct {
+		name        string
+		userId      uuid.UUID
+		roleBinding *entities.RoleBinding
+		role        enums.Role
+	}{
+		{
+			name:   "owner of the tenant is admin",
+			userId: tenantId,
+			role:   enums.RoleAdmin,
+		},
+		{
+			name:        "bound user gets the role of the binding",
+			userId:      userId,
+			roleBinding: &entities.RoleBinding{TenantId: tenantId, UserId: userId, Role: enums.RoleEditor},
+			role:        enums.RoleEditor,
+		},
+		{
+			name:   "user without a binding has no role",
+			userId: userId,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			iTracer := tracer.NewMockITracer(mockController)
+			iSpan := tracer.NewMockISpan(mockController)
+			iRoleBindingRepository := interfaces.NewMockIRoleBindingRepository(mockController)
+			userRoleQueryHandler := newUserRoleQueryHandler(nil, iTracer, iRoleBindingRepository)
+			ctx := contextplus.Background()
+
+			iTracer.EXPECT().SpanFromContext(ctx).Return(iSpan, ctx).Times(1)
+			iSpan.EXPECT().Finish().Times(1)
+			if tableTest.userId != tenantId {
+				iRoleBindingRepository.EXPECT().FirstOrDefault(
+					ctx,
+					genericRepository.Equal("tenant_id", tenantId),
+					genericRepository.Equal("user_id", tableTest.userId),
+				).Return(tableTest.roleBinding, nil).Times(1)
+			}
+
+			role, err := userRoleQueryHandler.Handle(ctx, NewUserRoleQuery(tenantId, tableTest.userId))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tableTest.role, role)
+		})
+	}
+}
@@ -2,30 +2,63 @@ package interfaces
 
 import (
 	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
 	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
 	"net/http"
 	"time"
 )
 
-//go:generate mockgen -destination=./infrastructure_mock.go -package=interfaces . ICron,INotification,IRedis,IRest
+//go:generate mockgen -destination=./infrastructure_mock.go -package=interfaces . ICron,INotification,IRedis,IRest,IGrpcProbe,IDnsProbe,IPostgresProbe,IRedisProbe,ICipher
 
 type ICron interface {
 	AddJob(key uint, createAt time.Time, interval string, job func()) error
 	RemoveJob(key uint)
+	AddFunc(key string, spec string, job func()) error
+	RemoveFunc(key string)
+	Validate(spec string) error
+	Ping() error
+	Stop()
+}
+
+type ICipher interface {
+	Encrypt(tenantId uuid.UUID, field string, value string) (string, error)
+	Decrypt(tenantId uuid.UUID, field string, value string) (string, error)
+	Rotate(tenantId uuid.UUID, field string, value string) (string, error)
 }
 
 type INotification interface {
-	Send(ctx *contextplus.Context, subject string, message string) error
+	Providers() []enums.NotificationProvider
+	Send(ctx *contextplus.Context, provider enums.NotificationProvider, receiver string, subject string, message string) error
 }
 
 type IRedis interface {
-	Set(ctx *contextplus.Context, key string, value any) error
+	Set(ctx *contextplus.Context, key string, value any, ttl time.Duration) error
+	SetNX(ctx *contextplus.Context, key string, value any, ttl time.Duration) (bool, error)
 	Get(ctx *contextplus.Context, key string) (string, error)
+	Del(ctx *contextplus.Context, key string) error
 	Publish(ctx *contextplus.Context, channelName string, message any) error
 	Subscribe(ctx *contextplus.Context, channelName string, channel chan<- string)
+	Ping(ctx *contextplus.Context) error
 	Close() error
 }
 
 type IRest interface {
-	Execute(ctx *contextplus.Context, method enums.HttpMethod, url string, headers map[string]string, body map[string]any) (int, http.Header, string, error)
+	Execute(ctx *contextplus.Context, method enums.HttpMethod, url string, headers map[string]string, body map[string]any, sensitive valueObjects.Sensitive) (int, http.Header, string, error)
+}
+
+type IGrpcProbe interface {
+	Check(ctx *contextplus.Context, target string, settings valueObjects.GrpcProbeSettings) (string, map[string][]string, error)
+}
+
+type IDnsProbe interface {
+	Lookup(ctx *contextplus.Context, host string, settings valueObjects.DnsProbeSettings) (string, []string, error)
+}
+
+type IPostgresProbe interface {
+	Query(ctx *contextplus.Context, target string, settings valueObjects.PostgresProbeSettings) (string, time.Duration, time.Duration, error)
+}
+
+type IRedisProbe interface {
+	Command(ctx *contextplus.Context, target string, settings valueObjects.RedisProbeSettings) (string, time.Duration, time.Duration, error)
 }
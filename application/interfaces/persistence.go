@@ -2,22 +2,106 @@ package interfaces
 
 import (
 	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
 	"health-check/domain/entities"
+	"health-check/domain/valueObjects"
 	"health-check/pkg/genericRepository"
+	"time"
 )
 
-//go:generate mockgen -destination=./persistence_mock.go -package=interfaces . IHealthCheckRepository,IHealthCheckRequestRepository,IUnitOfWork
+//go:generate mockgen -destination=./persistence_mock.go -package=interfaces . IHealthCheckRepository,IHealthCheckRequestRepository,INotificationDeliveryRepository,INotificationChannelRepository,IEscalationPolicyRepository,IIncidentRepository,ITagRepository,ISilenceRepository,IDigestRepository,IHealthCheckDependencyRepository,IHealthCheckLabelRepository,IRoleBindingRepository,IApiKeyRepository,IAuditLogRepository,ISecretRepository,IUnitOfWork
 
 type IHealthCheckRepository interface {
 	genericRepository.IGenericRepository[entities.HealthCheck]
+	ReplaceTags(ctx *contextplus.Context, healthCheck *entities.HealthCheck, tags []entities.Tag) error
+	IncrementVersion(ctx *contextplus.Context, healthCheck *entities.HealthCheck) (bool, error)
+	AllWithRelations(ctx *contextplus.Context, specifications ...genericRepository.Specification) ([]entities.HealthCheck, error)
 }
 
 type IHealthCheckRequestRepository interface {
 	genericRepository.IGenericRepository[entities.HealthCheckRequest]
+	Recent(ctx *contextplus.Context, healthCheckId uint, limit uint) ([]entities.HealthCheckRequest, error)
+	Stats(ctx *contextplus.Context, healthCheckIds []uint, from time.Time, to time.Time) ([]valueObjects.HealthCheckStats, error)
+}
+
+type INotificationDeliveryRepository interface {
+	genericRepository.IGenericRepository[entities.NotificationDelivery]
+	Claim(ctx *contextplus.Context, limit uint, lease time.Duration) ([]entities.NotificationDelivery, error)
+}
+
+type INotificationChannelRepository interface {
+	genericRepository.IGenericRepository[entities.NotificationChannel]
+	Routed(ctx *contextplus.Context, tenantId uuid.UUID, healthCheckId uint) ([]entities.NotificationChannel, error)
+}
+
+type IEscalationPolicyRepository interface {
+	genericRepository.IGenericRepository[entities.EscalationPolicy]
+}
+
+type IIncidentRepository interface {
+	genericRepository.IGenericRepository[entities.Incident]
+	ClaimDueEscalations(ctx *contextplus.Context, limit uint, lease time.Duration) ([]entities.Incident, error)
+	UpdateEscalation(ctx *contextplus.Context, incident *entities.Incident) (bool, error)
+	UpdateSuppression(ctx *contextplus.Context, incident *entities.Incident) (bool, error)
+	Resolve(ctx *contextplus.Context, incident *entities.Incident) (bool, error)
+}
+
+type ITagRepository interface {
+	genericRepository.IGenericRepository[entities.Tag]
+	HealthCheckIds(ctx *contextplus.Context, tenantId uuid.UUID, names []string) ([]uint, error)
+}
+
+type ISilenceRepository interface {
+	genericRepository.IGenericRepository[entities.Silence]
+	Active(ctx *contextplus.Context, tenantId uuid.UUID, healthCheckId uint, now time.Time) (*entities.Silence, error)
+}
+
+type IDigestRepository interface {
+	genericRepository.IGenericRepository[entities.Digest]
+}
+
+type IHealthCheckDependencyRepository interface {
+	genericRepository.IGenericRepository[entities.HealthCheckDependency]
+	DownAncestor(ctx *contextplus.Context, healthCheckId uint) (*uint, error)
+	LockTenant(ctx *contextplus.Context, tenantId uuid.UUID) error
+}
+
+type IHealthCheckLabelRepository interface {
+	genericRepository.IGenericRepository[entities.HealthCheckLabel]
+	HealthCheckIds(ctx *contextplus.Context, selector map[string]string) ([]uint, error)
+}
+
+type IRoleBindingRepository interface {
+	genericRepository.IGenericRepository[entities.RoleBinding]
+}
+
+type IApiKeyRepository interface {
+	genericRepository.IGenericRepository[entities.ApiKey]
+}
+
+type IAuditLogRepository interface {
+	genericRepository.IGenericRepository[entities.AuditLog]
+}
+
+type ISecretRepository interface {
+	genericRepository.IGenericRepository[entities.Secret]
 }
 
 type IUnitOfWork interface {
 	HealthCheckRepository() IHealthCheckRepository
 	HealthCheckRequestRepository() IHealthCheckRequestRepository
+	NotificationDeliveryRepository() INotificationDeliveryRepository
+	NotificationChannelRepository() INotificationChannelRepository
+	EscalationPolicyRepository() IEscalationPolicyRepository
+	IncidentRepository() IIncidentRepository
+	TagRepository() ITagRepository
+	SilenceRepository() ISilenceRepository
+	DigestRepository() IDigestRepository
+	HealthCheckDependencyRepository() IHealthCheckDependencyRepository
+	HealthCheckLabelRepository() IHealthCheckLabelRepository
+	RoleBindingRepository() IRoleBindingRepository
+	ApiKeyRepository() IApiKeyRepository
+	AuditLogRepository() IAuditLogRepository
+	SecretRepository() ISecretRepository
 	Do(*contextplus.Context, func(IUnitOfWork) error) error
 }
@@ -0,0 +1,84 @@
+package application
+
+import (
+	"context"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	ReadinessComponentPostgres          = "postgres"
+	ReadinessComponentRedis             = "redis"
+	ReadinessComponentCron              = "cron"
+	ReadinessComponentRedisSubscription = "redisSubscription"
+)
+
+// SReadiness checks the dependencies an instance needs before it can take traffic.
+type SReadiness struct {
+	iLogger      logger.ILogger
+	timeout      time.Duration
+	components   map[string]func(ctx *contextplus.Context) error
+	shuttingDown atomic.Bool
+}
+
+func newReadiness(iLogger logger.ILogger, timeout time.Duration, components map[string]func(ctx *contextplus.Context) error) *SReadiness {
+	return &SReadiness{
+		iLogger:    iLogger,
+		timeout:    timeout,
+		components: components,
+	}
+}
+
+// Check pings every component concurrently, each one is given up on after the configured timeout.
+func (r *SReadiness) Check(ctx *contextplus.Context) valueObjects.Readiness {
+	readiness := valueObjects.Readiness{
+		Status:     enums.ReadinessStatusUp,
+		Components: make(map[string]valueObjects.ComponentReadiness, len(r.components)),
+	}
+
+	var (
+		mutex     sync.Mutex
+		waitGroup sync.WaitGroup
+	)
+	for name, ping := range r.components {
+		waitGroup.Add(1)
+		go func(name string, ping func(ctx *contextplus.Context) error) {
+			defer waitGroup.Done()
+
+			checkCtx := *ctx
+			var cancel context.CancelFunc
+			checkCtx.Context, cancel = context.WithTimeout(ctx.Context, r.timeout)
+			defer cancel()
+
+			component := valueObjects.ComponentReadiness{Status: enums.ReadinessStatusUp}
+			if err := ping(&checkCtx); err != nil {
+				r.iLogger.WithError(err).WithString("component", name).Warn(ctx, "component is not ready")
+				component = valueObjects.ComponentReadiness{Status: enums.ReadinessStatusDown, Error: err.Error()}
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			readiness.Components[name] = component
+			if component.Status != enums.ReadinessStatusUp {
+				readiness.Status = enums.ReadinessStatusDown
+			}
+		}(name, ping)
+	}
+	waitGroup.Wait()
+
+	if r.shuttingDown.Load() {
+		readiness.Status = enums.ReadinessStatusShuttingDown
+	}
+
+	return readiness
+}
+
+// Shutdown marks the instance as not ready so load balancers stop routing to it while it drains.
+func (r *SReadiness) Shutdown() {
+	r.shuttingDown.Store(true)
+}
@@ -0,0 +1,93 @@
+package application
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"testing"
+	"time"
+)
+
+func TestReadiness_Check(t *testing.T) {
+	up := func(ctx *contextplus.Context) error { return nil }
+	down := func(ctx *contextplus.Context) error { return errors.New("connection refused") }
+	slow := func(ctx *contextplus.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	tableTests := []struct {
+		name         string
+		components   map[string]func(ctx *contextplus.Context) error
+		shuttingDown bool
+		expected     valueObjects.Readiness
+	}{
+		{
+			name:       "all components up",
+			components: map[string]func(ctx *contextplus.Context) error{ReadinessComponentPostgres: up, ReadinessComponentRedis: up},
+			expected: valueObjects.Readiness{
+				Status: enums.ReadinessStatusUp,
+				Components: map[string]valueObjects.ComponentReadiness{
+					ReadinessComponentPostgres: {Status: enums.ReadinessStatusUp},
+					ReadinessComponentRedis:    {Status: enums.ReadinessStatusUp},
+				},
+			},
+		},
+		{
+			name:       "one component down",
+			components: map[string]func(ctx *contextplus.Context) error{ReadinessComponentPostgres: up, ReadinessComponentRedis: down},
+			expected: valueObjects.Readiness{
+				Status: enums.ReadinessStatusDown,
+				Components: map[string]valueObjects.ComponentReadiness{
+					ReadinessComponentPostgres: {Status: enums.ReadinessStatusUp},
+					ReadinessComponentRedis:    {Status: enums.ReadinessStatusDown, Error: "connection refused"},
+				},
+			},
+		},
+		{
+			name:       "component exceeds timeout",
+			components: map[string]func(ctx *contextplus.Context) error{ReadinessComponentPostgres: slow},
+			expected: valueObjects.Readiness{
+				Status: enums.ReadinessStatusDown,
+				Components: map[string]valueObjects.ComponentReadiness{
+					ReadinessComponentPostgres: {Status: enums.ReadinessStatusDown, Error: "context deadline exceeded"},
+				},
+			},
+		},
+		{
+			name:         "shutting down",
+			components:   map[string]func(ctx *contextplus.Context) error{ReadinessComponentCron: up},
+			shuttingDown: true,
+			expected: valueObjects.Readiness{
+				Status: enums.ReadinessStatusShuttingDown,
+				Components: map[string]valueObjects.ComponentReadiness{
+					ReadinessComponentCron: {Status: enums.ReadinessStatusUp},
+				},
+			},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			iLogger := logger.NewMockILogger(mockController)
+			iLogger.EXPECT().WithError(gomock.Any()).Return(iLogger).AnyTimes()
+			iLogger.EXPECT().WithString(gomock.Any(), gomock.Any()).Return(iLogger).AnyTimes()
+			iLogger.EXPECT().Warn(gomock.Any(), "component is not ready").AnyTimes()
+
+			readiness := newReadiness(iLogger, 10*time.Millisecond, tableTest.components)
+			if tableTest.shuttingDown {
+				readiness.Shutdown()
+			}
+
+			actual := readiness.Check(contextplus.Background())
+
+			assert.Equal(t, tableTest.expected, actual)
+			assert.Equal(t, tableTest.expected.Status == enums.ReadinessStatusUp, actual.IsReady())
+		})
+	}
+}
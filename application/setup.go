@@ -7,6 +7,7 @@ import (
 	"health-check/application/handlers/queries"
 	"health-check/infrastructure"
 	"health-check/persistence"
+	"time"
 )
 
 type Application struct {
@@ -14,25 +15,78 @@ type Application struct {
 	Commands       commands.Commands
 	Queries        queries.Queries
 	Jobs           jobs.Jobs
+	Readiness      *SReadiness
 }
 
 func NewApplication(infrastructure *infrastructure.Infrastructure, persistence *persistence.Persistence) *Application {
+	newCommands := commands.NewCommands(infrastructure, persistence)
+	newJobs := jobs.NewJobs(infrastructure, persistence, newCommands)
 	return &Application{
 		infrastructure: infrastructure,
-		Commands:       commands.NewCommands(infrastructure, persistence),
+		Commands:       newCommands,
 		Queries:        queries.NewQueries(infrastructure, persistence),
-		Jobs:           jobs.NewJobs(infrastructure, persistence),
+		Jobs:           newJobs,
+		Readiness: newReadiness(infrastructure.ILogger, time.Duration(infrastructure.SConfig.Readiness.TimeoutSecond)*time.Second, map[string]func(ctx *contextplus.Context) error{
+			ReadinessComponentPostgres: infrastructure.SPostgres.Ping,
+			ReadinessComponentRedis:    infrastructure.IRedis.Ping,
+			ReadinessComponentCron: func(*contextplus.Context) error {
+				return infrastructure.ICron.Ping()
+			},
+			ReadinessComponentRedisSubscription: func(*contextplus.Context) error {
+				return newJobs.HealthCheck.Ping()
+			},
+		}),
 	}
 }
 
 func (r Application) StartJobs(ctx *contextplus.Context) {
+	if err := r.Jobs.SecretRotation.Start(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Fatal(ctx, "error in start secret rotation job")
+	}
+
 	if err := r.Jobs.HealthCheck.Start(ctx); err != nil {
 		r.infrastructure.ILogger.WithError(err).Fatal(ctx, "error in start health check job")
 	}
+
+	if err := r.Jobs.NotificationDelivery.Start(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Fatal(ctx, "error in start notification delivery job")
+	}
+
+	if err := r.Jobs.Escalation.Start(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Fatal(ctx, "error in start escalation job")
+	}
+
+	if err := r.Jobs.Digest.Start(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Fatal(ctx, "error in start digest job")
+	}
+
+	if err := r.Jobs.GitOps.Start(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Fatal(ctx, "error in start gitops job")
+	}
 }
 
 func (r Application) StopJobs(ctx *contextplus.Context) {
 	if err := r.Jobs.HealthCheck.Stop(ctx); err != nil {
 		r.infrastructure.ILogger.WithError(err).Error(ctx, "error in stop health check job")
 	}
+
+	if err := r.Jobs.NotificationDelivery.Stop(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Error(ctx, "error in stop notification delivery job")
+	}
+
+	if err := r.Jobs.Escalation.Stop(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Error(ctx, "error in stop escalation job")
+	}
+
+	if err := r.Jobs.Digest.Stop(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Error(ctx, "error in stop digest job")
+	}
+
+	if err := r.Jobs.GitOps.Stop(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Error(ctx, "error in stop gitops job")
+	}
+
+	if err := r.Jobs.SecretRotation.Stop(ctx); err != nil {
+		r.infrastructure.ILogger.WithError(err).Error(ctx, "error in stop secret rotation job")
+	}
 }
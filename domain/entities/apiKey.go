@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"health-check/domain/enums"
+	"slices"
+	"time"
+)
+
+const apiKeyPrefix = "hck_"
+
+type ApiKey struct {
+	Id         uint                                   `gorm:"primaryKey;"`
+	TenantId   uuid.UUID                              `gorm:"type:uuid;not null;index"`
+	Name       string                                 `gorm:"size:100;not null"`
+	Prefix     string                                 `gorm:"size:20;not null"`
+	KeyHash    string                                 `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	Scopes     datatypes.JSONType[[]enums.Permission] `gorm:"not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedBy  uuid.UUID `gorm:"type:uuid;not null"`
+	Base3
+
+	Key string `gorm:"-" json:"-"`
+}
+
+func NewApiKey(tenantId uuid.UUID, name string, scopes []enums.Permission, expiresAt *time.Time, createdBy uuid.UUID) (ApiKey, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return ApiKey{}, err
+	}
+	key := apiKeyPrefix + hex.EncodeToString(secret)
+	return ApiKey{
+		TenantId:  tenantId,
+		Name:      name,
+		Prefix:    key[:len(apiKeyPrefix)+8],
+		KeyHash:   HashApiKey(key),
+		Scopes:    datatypes.NewJSONType(scopes),
+		ExpiresAt: expiresAt,
+		CreatedBy: createdBy,
+		Key:       key,
+	}, nil
+}
+
+func HashApiKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func (r *ApiKey) IsExpired(now time.Time) bool {
+	return r.ExpiresAt != nil && !now.Before(*r.ExpiresAt)
+}
+
+func (r *ApiKey) Allows(permission enums.Permission) bool {
+	return slices.Contains(r.Scopes.Data(), permission)
+}
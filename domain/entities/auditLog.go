@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"health-check/domain/enums"
+	"time"
+)
+
+type AuditLog struct {
+	Id        uint              `gorm:"primaryKey;"`
+	TenantId  uuid.UUID         `gorm:"type:uuid;not null;index"`
+	ActorId   uuid.UUID         `gorm:"type:uuid;not null;index"`
+	RequestId string            `gorm:"size:100;not null"`
+	Action    enums.AuditAction `gorm:"size:30;not null"`
+	Entity    string            `gorm:"size:100;not null;index:idx_audit_logs_entity"`
+	EntityId  uint              `gorm:"not null;index:idx_audit_logs_entity"`
+	Before    datatypes.JSON
+	After     datatypes.JSON
+	CreatedAt time.Time `gorm:"not null;index"`
+}
+
+func NewAuditLog(tenantId uuid.UUID, actorId uuid.UUID, requestId string, action enums.AuditAction, entity string, entityId uint, before datatypes.JSON, after datatypes.JSON) AuditLog {
+	return AuditLog{
+		TenantId:  tenantId,
+		ActorId:   actorId,
+		RequestId: requestId,
+		Action:    action,
+		Entity:    entity,
+		EntityId:  entityId,
+		Before:    before,
+		After:     after,
+	}
+}
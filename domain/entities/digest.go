@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"health-check/domain/enums"
+)
+
+type Digest struct {
+	Id                    uint                                  `gorm:"primaryKey;"`
+	TenantId              uuid.UUID                             `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index"`
+	NotificationChannelId uint                                  `gorm:"not null;index"`
+	Schedule              string                                `gorm:"size:100;not null"`
+	Period                enums.DigestPeriod                    `gorm:"size:30;not null"`
+	Tag                   *string                               `gorm:"size:100"`
+	Labels                datatypes.JSONType[map[string]string] `gorm:"not null;default:'{}'"`
+	Base3
+}
+
+func NewDigest(tenantId uuid.UUID, notificationChannelId uint, schedule string, period enums.DigestPeriod, tag *string, labels map[string]string) Digest {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return Digest{
+		TenantId:              tenantId,
+		NotificationChannelId: notificationChannelId,
+		Schedule:              schedule,
+		Period:                period,
+		Tag:                   tag,
+		Labels:                datatypes.NewJSONType(labels),
+	}
+}
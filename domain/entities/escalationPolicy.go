@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"health-check/domain/valueObjects"
+	"time"
+)
+
+type EscalationPolicy struct {
+	Id       uint                                              `gorm:"primaryKey;"`
+	TenantId uuid.UUID                                         `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index"`
+	Name     string                                            `gorm:"size:100;not null"`
+	Steps    datatypes.JSONType[[]valueObjects.EscalationStep] `gorm:"not null"`
+	Base3
+}
+
+func NewEscalationPolicy(tenantId uuid.UUID, name string, steps []valueObjects.EscalationStep) EscalationPolicy {
+	return EscalationPolicy{
+		TenantId: tenantId,
+		Name:     name,
+		Steps:    datatypes.NewJSONType(steps),
+	}
+}
+
+// Step returns the step at index and, when there is one after it, the time it becomes due.
+func (r EscalationPolicy) Step(index uint, now time.Time) (step *valueObjects.EscalationStep, next *time.Time) {
+	steps := r.Steps.Data()
+	if index >= uint(len(steps)) {
+		return nil, nil
+	}
+	if index+1 < uint(len(steps)) {
+		nextAt := now.Add(time.Duration(steps[index+1].DelayMinute) * time.Minute)
+		next = &nextAt
+	}
+	return &steps[index], next
+}
@@ -1,32 +1,173 @@
 package entities
 
 import (
+	"github.com/google/uuid"
 	"gorm.io/datatypes"
 	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"slices"
+	"strings"
+	"time"
 )
 
 type HealthCheck struct {
-	Id       uint                                  `gorm:"primaryKey;"`
-	Interval string                                `gorm:"size:30;not null"`
-	Url      string                                `gorm:"size:600;not null"`
-	Method   enums.HttpMethod                      `gorm:"size:30;not null"`
-	Headers  datatypes.JSONType[map[string]string] `gorm:"not null"`
-	Body     datatypes.JSONType[map[string]any]    `gorm:"not null"`
-	Status   enums.Status                          `gorm:"size:30;not null"`
+	Id                 uint                                           `gorm:"primaryKey;"`
+	TenantId           uuid.UUID                                      `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index;uniqueIndex:idx_health_checks_owner_name,priority:1"`
+	Name               string                                         `gorm:"size:100;not null;default:'';uniqueIndex:idx_health_checks_owner_name,where:deleted_at IS NULL AND name <> ''"`
+	Description        string                                         `gorm:"size:1000;not null;default:''"`
+	RunbookUrl         string                                         `gorm:"size:600;not null;default:''"`
+	Owner              string                                         `gorm:"size:100;not null;default:'';uniqueIndex:idx_health_checks_owner_name"`
+	Interval           string                                         `gorm:"size:30;not null"`
+	Url                string                                         `gorm:"size:600;not null"`
+	Method             enums.HttpMethod                               `gorm:"size:30;not null"`
+	Headers            datatypes.JSONType[map[string]string]          `gorm:"not null"`
+	Body               datatypes.JSONType[map[string]any]             `gorm:"not null"`
+	Type               enums.ProbeType                                `gorm:"size:30;not null;default:'http'"`
+	Settings           datatypes.JSONType[valueObjects.ProbeSettings] `gorm:"not null;default:'{}'"`
+	HeartbeatToken     *string                                        `gorm:"size:64;uniqueIndex"`
+	Status             enums.Status                                   `gorm:"size:30;not null"`
+	EscalationPolicyId *uint                                          `gorm:"index"`
+	Flapping           bool                                           `gorm:"not null;default:false"`
+	Tags               []Tag                                          `gorm:"many2many:health_check_tags;"`
+	Labels             []HealthCheckLabel
+	Version            uint `gorm:"not null;default:1"`
+	Managed            bool `gorm:"not null;default:false"`
 	Base3
 }
 
-func NewHealthCheck(interval string, url string, method enums.HttpMethod, headers map[string]string, body map[string]any, status enums.Status) HealthCheck {
-	return HealthCheck{
-		Interval: interval,
-		Url:      url,
-		Method:   method,
-		Headers:  datatypes.NewJSONType(headers),
-		Body:     datatypes.NewJSONType(body),
-		Status:   status,
+func NewHealthCheck(tenantId uuid.UUID, name string, description string, runbookUrl string, owner string, interval string, url string, method enums.HttpMethod, headers map[string]string, body map[string]any, probeType enums.ProbeType, settings valueObjects.ProbeSettings, status enums.Status, escalationPolicyId *uint) HealthCheck {
+	healthCheck := HealthCheck{
+		TenantId:           tenantId,
+		Name:               name,
+		Description:        description,
+		RunbookUrl:         runbookUrl,
+		Owner:              owner,
+		Interval:           interval,
+		Url:                url,
+		Method:             method,
+		Headers:            datatypes.NewJSONType(headers),
+		Body:               datatypes.NewJSONType(body),
+		Type:               probeType,
+		Settings:           datatypes.NewJSONType(settings),
+		Status:             status,
+		EscalationPolicyId: escalationPolicyId,
+		Version:            1,
 	}
+	healthCheck.syncHeartbeatToken()
+	return healthCheck
+}
+
+func (r *HealthCheck) SetTags(tags []Tag) {
+	r.Tags = tags
+}
+
+func (r *HealthCheck) TagNames() []string {
+	names := make([]string, 0, len(r.Tags))
+	for _, tag := range r.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+func (r *HealthCheck) SetLabels(labels map[string]string) {
+	r.Labels = make([]HealthCheckLabel, 0, len(labels))
+	for key, value := range labels {
+		r.Labels = append(r.Labels, NewHealthCheckLabel(r.Id, key, value))
+	}
+}
+
+func (r *HealthCheck) LabelMap() map[string]string {
+	labels := make(map[string]string, len(r.Labels))
+	for _, label := range r.Labels {
+		labels[label.Key] = label.Value
+	}
+	return labels
+}
+
+func (r *HealthCheck) Update(name string, description string, runbookUrl string, owner string, interval string, url string, method enums.HttpMethod, headers map[string]string, body map[string]any, probeType enums.ProbeType, settings valueObjects.ProbeSettings, escalationPolicyId *uint) {
+	r.Name = name
+	r.Description = description
+	r.RunbookUrl = runbookUrl
+	r.Owner = owner
+	r.Interval = interval
+	r.Url = url
+	r.Method = method
+	r.Headers = datatypes.NewJSONType(headers)
+	r.Body = datatypes.NewJSONType(body)
+	r.Type = probeType
+	r.Settings = datatypes.NewJSONType(settings)
+	r.EscalationPolicyId = escalationPolicyId
+	r.syncHeartbeatToken()
+}
+
+// syncHeartbeatToken gives a heartbeat check the token of its ping url and keeps it across updates, other types have none.
+func (r *HealthCheck) syncHeartbeatToken() {
+	if r.Type != enums.ProbeTypeHeartbeat {
+		r.HeartbeatToken = nil
+		return
+	}
+	if r.HeartbeatToken == nil {
+		token := strings.ReplaceAll(uuid.NewString(), "-", "")
+		r.HeartbeatToken = &token
+	}
+}
+
+// HeartbeatDeadline returns when a heartbeat check goes down without another ping, counted from the last one or from
+// the creation of the check when it was never pinged.
+func (r *HealthCheck) HeartbeatDeadline(lastPingAt *time.Time) (time.Time, error) {
+	period, err := time.ParseDuration(r.Interval)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	from := r.CreatedAt
+	if lastPingAt != nil && lastPingAt.After(from) {
+		from = *lastPingAt
+	}
+
+	return from.Add(period + time.Duration(r.Settings.Data().HeartbeatOrDefault().GraceSecond)*time.Second), nil
 }
 
 func (r *HealthCheck) SetStatus(status enums.Status) {
 	r.Status = status
 }
+
+func (r *HealthCheck) SetManaged(managed bool) {
+	r.Managed = managed
+}
+
+func (r *HealthCheck) IncrementVersion() {
+	r.Version++
+}
+
+// Redacted returns a copy of the health check with its header values and body strings redacted the way the responses
+// redact them, for a copy that is kept outside the database.
+func (r *HealthCheck) Redacted() *HealthCheck {
+	redacted := *r
+	redacted.Headers = datatypes.NewJSONType(valueObjects.RedactHeaders(r.Headers.Data()))
+	redacted.Body = datatypes.NewJSONType(valueObjects.RedactBody(r.Body.Data()))
+	return &redacted
+}
+
+// Definition returns the portable form of the health check, escalationPolicy is the name of its escalation policy.
+func (r *HealthCheck) Definition(escalationPolicy string) valueObjects.HealthCheckDefinition {
+	tags := r.TagNames()
+	slices.Sort(tags)
+
+	return valueObjects.HealthCheckDefinition{
+		Name:             r.Name,
+		Description:      r.Description,
+		RunbookUrl:       r.RunbookUrl,
+		Owner:            r.Owner,
+		Interval:         r.Interval,
+		Url:              r.Url,
+		Method:           r.Method,
+		Headers:          r.Headers.Data(),
+		Body:             r.Body.Data(),
+		Type:             r.Type,
+		Settings:         r.Settings.Data(),
+		EscalationPolicy: escalationPolicy,
+		Tags:             tags,
+		Labels:           r.LabelMap(),
+	}
+}
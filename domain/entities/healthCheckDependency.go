@@ -0,0 +1,48 @@
+package entities
+
+import "github.com/google/uuid"
+
+type HealthCheckDependency struct {
+	Id            uint      `gorm:"primaryKey;"`
+	TenantId      uuid.UUID `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index"`
+	HealthCheckId uint      `gorm:"not null;uniqueIndex:idx_health_check_dependencies_edge"`
+	ParentId      uint      `gorm:"not null;uniqueIndex:idx_health_check_dependencies_edge;index"`
+	Base1
+}
+
+func NewHealthCheckDependency(tenantId uuid.UUID, healthCheckId uint, parentId uint) HealthCheckDependency {
+	return HealthCheckDependency{
+		TenantId:      tenantId,
+		HealthCheckId: healthCheckId,
+		ParentId:      parentId,
+	}
+}
+
+// CreatesDependencyCycle reports whether making parentId a parent of healthCheckId would close a cycle, which is
+// the case when healthCheckId is already reachable by walking up from parentId.
+func CreatesDependencyCycle(dependencies []HealthCheckDependency, healthCheckId uint, parentId uint) bool {
+	parents := make(map[uint][]uint, len(dependencies))
+	for _, dependency := range dependencies {
+		parents[dependency.HealthCheckId] = append(parents[dependency.HealthCheckId], dependency.ParentId)
+	}
+
+	visited := make(map[uint]bool)
+	stack := []uint{parentId}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if id == healthCheckId {
+			return true
+		}
+
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+
+		stack = append(stack, parents[id]...)
+	}
+
+	return false
+}
@@ -0,0 +1,16 @@
+package entities
+
+type HealthCheckLabel struct {
+	Id            uint   `gorm:"primaryKey;"`
+	HealthCheckId uint   `gorm:"not null;uniqueIndex:idx_health_check_labels_health_check_key"`
+	Key           string `gorm:"size:100;not null;uniqueIndex:idx_health_check_labels_health_check_key;index:idx_health_check_labels_key_value"`
+	Value         string `gorm:"size:200;not null;index:idx_health_check_labels_key_value"`
+}
+
+func NewHealthCheckLabel(healthCheckId uint, key string, value string) HealthCheckLabel {
+	return HealthCheckLabel{
+		HealthCheckId: healthCheckId,
+		Key:           key,
+		Value:         value,
+	}
+}
@@ -2,24 +2,86 @@ package entities
 
 import (
 	"gorm.io/datatypes"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	HealthCheckRequestStatusMissed = "missed"
+
+	maxHeartbeatLogSize = 10000
 )
 
 type HealthCheckRequest struct {
-	Id            uint                                    `gorm:"primaryKey;"`
-	HealthCheckId uint                                    `gorm:"not null"`
-	Headers       datatypes.JSONType[map[string][]string] `gorm:"not null"`
-	Body          string                                  `gorm:"not null"`
-	StatusCode    int                                     `gorm:"not null"`
+	Id                  uint                                    `gorm:"primaryKey;"`
+	HealthCheckId       uint                                    `gorm:"not null;index"`
+	Headers             datatypes.JSONType[map[string][]string] `gorm:"not null"`
+	Body                string                                  `gorm:"not null"`
+	StatusCode          int                                     `gorm:"not null"`
+	Status              string                                  `gorm:"size:100;not null;default:''"`
+	Duration            int64                                   `gorm:"not null;default:0"`
+	UnreachableParentId *uint
 	Base1
 
 	HealthCheck HealthCheck
 }
 
-func NewHealthCheckRequest(healthCheckId uint, headers map[string][]string, body string, statusCode int) HealthCheckRequest {
+func NewHealthCheckRequest(healthCheckId uint, headers map[string][]string, body string, statusCode int, duration time.Duration) HealthCheckRequest {
 	return HealthCheckRequest{
 		HealthCheckId: healthCheckId,
 		Headers:       datatypes.NewJSONType(headers),
 		Body:          body,
 		StatusCode:    statusCode,
+		Duration:      duration.Milliseconds(),
+	}
+}
+
+// NewHealthCheckProbeRequest records the result of a probe that does not speak http, status is the answer in the probed
+// protocol and the status code is set to 200 or 503 so success is counted the same way for every probe type.
+func NewHealthCheckProbeRequest(healthCheckId uint, headers map[string][]string, body string, status string, healthy bool, duration time.Duration) HealthCheckRequest {
+	statusCode := http.StatusServiceUnavailable
+	if healthy {
+		statusCode = http.StatusOK
+	}
+	healthCheckRequest := NewHealthCheckRequest(healthCheckId, headers, body, statusCode, duration)
+	healthCheckRequest.Status = status
+	return healthCheckRequest
+}
+
+// NewHealthCheckHeartbeatRequest records a ping of a heartbeat check, the log sent with it is kept as the body.
+func NewHealthCheckHeartbeatRequest(healthCheckId uint, signal enums.HeartbeatSignal, log string, duration time.Duration) HealthCheckRequest {
+	if len(log) > maxHeartbeatLogSize {
+		log = strings.ToValidUTF8(log[:maxHeartbeatLogSize], "")
+	}
+	return NewHealthCheckProbeRequest(healthCheckId, nil, log, signal.String(), signal != enums.HeartbeatSignalFail, duration)
+}
+
+// NewHealthCheckMissedRequest records that a heartbeat check got no ping before its deadline.
+func NewHealthCheckMissedRequest(healthCheckId uint, deadline time.Time) HealthCheckRequest {
+	return NewHealthCheckProbeRequest(healthCheckId, nil, "no ping before "+deadline.Format(time.RFC3339), HealthCheckRequestStatusMissed, false, 0)
+}
+
+// IsHeartbeatPing reports whether the request is a ping of a heartbeat check rather than a missed deadline.
+func (r *HealthCheckRequest) IsHeartbeatPing() bool {
+	return enums.HeartbeatSignal(r.Status).IsValid()
+}
+
+// Mask replaces the secret values the request was rendered with wherever the answer echoes them.
+func (r *HealthCheckRequest) Mask(sensitive valueObjects.Sensitive) {
+	if len(sensitive) == 0 {
+		return
 	}
+	r.Body = sensitive.Mask(r.Body)
+	r.Headers = datatypes.NewJSONType(map[string][]string(sensitive.MaskHeader(r.Headers.Data())))
+}
+
+func (r *HealthCheckRequest) IsSuccess() bool {
+	return r.StatusCode == http.StatusOK
+}
+
+func (r *HealthCheckRequest) SetUnreachable(parentId uint) {
+	r.UnreachableParentId = &parentId
 }
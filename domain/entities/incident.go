@@ -0,0 +1,108 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"time"
+)
+
+type Incident struct {
+	Id                  uint                                                  `gorm:"primaryKey;"`
+	TenantId            uuid.UUID                                             `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index"`
+	HealthCheckId       uint                                                  `gorm:"not null;index"`
+	Status              enums.IncidentStatus                                  `gorm:"size:30;not null;index"`
+	EscalationStep      uint                                                  `gorm:"not null"`
+	NextEscalationAt    *time.Time                                            `gorm:"index"`
+	Escalations         datatypes.JSONType[[]valueObjects.IncidentEscalation] `gorm:"not null"`
+	UnreachableParentId *uint
+	Suppressed          bool `gorm:"not null;default:false"`
+	AcknowledgedAt      *time.Time
+	AcknowledgedBy      *uuid.UUID `gorm:"type:uuid"`
+	ResolvedAt          *time.Time
+	Base3
+}
+
+func NewIncident(tenantId uuid.UUID, healthCheckId uint, escalate bool) Incident {
+	incident := Incident{
+		TenantId:      tenantId,
+		HealthCheckId: healthCheckId,
+		Status:        enums.IncidentStatusOpen,
+		Escalations:   datatypes.NewJSONType([]valueObjects.IncidentEscalation{}),
+	}
+	if escalate {
+		now := time.Now()
+		incident.NextEscalationAt = &now
+	}
+	return incident
+}
+
+func (r *Incident) MarkUnreachable(parentId uint) {
+	r.UnreachableParentId = &parentId
+	r.NextEscalationAt = nil
+}
+
+func (r *Incident) MarkReachable(escalate bool) {
+	r.UnreachableParentId = nil
+	if escalate {
+		now := time.Now()
+		r.NextEscalationAt = &now
+	}
+}
+
+// Suppress holds back the notification and the escalation of an incident opened while its health check is flapping.
+func (r *Incident) Suppress() {
+	r.Suppressed = true
+	r.NextEscalationAt = nil
+}
+
+// Unsuppress releases an incident held back by Suppress once the flapping clears and the health check is still down.
+func (r *Incident) Unsuppress(escalate bool) {
+	r.Suppressed = false
+	if escalate {
+		now := time.Now()
+		r.NextEscalationAt = &now
+	}
+}
+
+func (r *Incident) Escalate(notificationChannelId uint, next *time.Time) {
+	r.Escalations = datatypes.NewJSONType(append(r.Escalations.Data(), valueObjects.IncidentEscalation{
+		Step:                  r.EscalationStep,
+		NotificationChannelId: notificationChannelId,
+		EscalatedAt:           time.Now(),
+	}))
+	r.EscalationStep++
+	r.NextEscalationAt = next
+}
+
+func (r *Incident) PostponeEscalation(until time.Time) {
+	r.NextEscalationAt = &until
+}
+
+func (r *Incident) StopEscalation() {
+	r.NextEscalationAt = nil
+}
+
+func (r *Incident) EscalatedChannelIds() []uint {
+	ids := make([]uint, 0, len(r.Escalations.Data()))
+	for _, escalation := range r.Escalations.Data() {
+		ids = append(ids, escalation.NotificationChannelId)
+	}
+	return ids
+}
+
+func (r *Incident) Acknowledge(userId uuid.UUID) {
+	now := time.Now()
+	r.Status = enums.IncidentStatusAcknowledged
+	r.AcknowledgedAt = &now
+	r.AcknowledgedBy = &userId
+	r.NextEscalationAt = nil
+}
+
+func (r *Incident) Resolve() {
+	now := time.Now()
+	r.Status = enums.IncidentStatusResolved
+	r.ResolvedAt = &now
+	r.NextEscalationAt = nil
+}
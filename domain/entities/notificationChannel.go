@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"health-check/domain/enums"
+)
+
+type NotificationChannel struct {
+	Id       uint                                  `gorm:"primaryKey;"`
+	TenantId uuid.UUID                             `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index;uniqueIndex:idx_notification_channels_name,priority:1"`
+	Name     string                                `gorm:"size:100;not null;uniqueIndex:idx_notification_channels_name,priority:2,where:deleted_at IS NULL"`
+	Provider enums.NotificationProvider            `gorm:"size:30;not null"`
+	Receiver string                                `gorm:"size:200;not null"`
+	Labels   datatypes.JSONType[map[string]string] `gorm:"not null;default:'{}'"`
+	Base3
+}
+
+func NewNotificationChannel(tenantId uuid.UUID, name string, provider enums.NotificationProvider, receiver string, labels map[string]string) NotificationChannel {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return NotificationChannel{
+		TenantId: tenantId,
+		Name:     name,
+		Provider: provider,
+		Receiver: receiver,
+		Labels:   datatypes.NewJSONType(labels),
+	}
+}
@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+	"time"
+)
+
+type NotificationDelivery struct {
+	Id            uint                       `gorm:"primaryKey;"`
+	TenantId      uuid.UUID                  `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index"`
+	Provider      enums.NotificationProvider `gorm:"size:30;not null"`
+	Receiver      string                     `gorm:"size:200;not null"`
+	Subject       string                     `gorm:"not null"`
+	Message       string                     `gorm:"not null"`
+	Status        enums.DeliveryStatus       `gorm:"size:30;not null;index:idx_notification_deliveries_due,priority:1"`
+	Attempts      uint                       `gorm:"not null"`
+	NextAttemptAt time.Time                  `gorm:"not null;index:idx_notification_deliveries_due,priority:2"`
+	LastError     string                     `gorm:"not null"`
+	SentAt        *time.Time
+	Base3
+}
+
+func NewNotificationDelivery(tenantId uuid.UUID, provider enums.NotificationProvider, receiver string, subject string, message string) NotificationDelivery {
+	return NotificationDelivery{
+		TenantId:      tenantId,
+		Provider:      provider,
+		Receiver:      receiver,
+		Subject:       subject,
+		Message:       message,
+		Status:        enums.DeliveryStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+}
+
+func (r *NotificationDelivery) MarkSent() {
+	now := time.Now()
+	r.Status = enums.DeliveryStatusSent
+	r.SentAt = &now
+	r.LastError = ""
+}
+
+func (r *NotificationDelivery) MarkFailed(err error, maxAttempts uint, backoff time.Duration) {
+	r.Attempts++
+	r.LastError = err.Error()
+	if r.Attempts >= maxAttempts {
+		r.Status = enums.DeliveryStatusDead
+		return
+	}
+	r.NextAttemptAt = time.Now().Add(backoff)
+}
+
+func (r *NotificationDelivery) Postpone(until time.Time) {
+	r.NextAttemptAt = until
+}
+
+func (r *NotificationDelivery) Retry() {
+	r.Status = enums.DeliveryStatusPending
+	r.Attempts = 0
+	r.NextAttemptAt = time.Now()
+}
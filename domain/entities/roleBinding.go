@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+)
+
+type RoleBinding struct {
+	Id        uint       `gorm:"primaryKey;"`
+	TenantId  uuid.UUID  `gorm:"type:uuid;not null;index;uniqueIndex:idx_role_bindings_tenant_user,priority:1"`
+	UserId    uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_role_bindings_tenant_user,priority:2,where:deleted_at IS NULL"`
+	Role      enums.Role `gorm:"size:30;not null"`
+	CreatedBy uuid.UUID  `gorm:"type:uuid;not null"`
+	Base3
+}
+
+func NewRoleBinding(tenantId uuid.UUID, userId uuid.UUID, role enums.Role, createdBy uuid.UUID) RoleBinding {
+	return RoleBinding{
+		TenantId:  tenantId,
+		UserId:    userId,
+		Role:      role,
+		CreatedBy: createdBy,
+	}
+}
@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+)
+
+// Secret is a credential the templates of the health checks read by name, its value is sealed at rest and is never
+// marshalled so it does not leave the service through a response or the audit log.
+type Secret struct {
+	Id          uint      `gorm:"primaryKey;"`
+	TenantId    uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_secrets_name,priority:1"`
+	Name        string    `gorm:"size:100;not null;uniqueIndex:idx_secrets_name,priority:2,where:deleted_at IS NULL"`
+	Description string    `gorm:"size:1000;not null;default:''"`
+	Value       string    `gorm:"not null" json:"-"`
+	Base3
+}
+
+func NewSecret(tenantId uuid.UUID, name string, description string, value string) Secret {
+	return Secret{
+		TenantId:    tenantId,
+		Name:        name,
+		Description: description,
+		Value:       value,
+	}
+}
+
+// SecretField names the field the value of the secret called name is sealed for.
+func SecretField(name string) string {
+	return "secrets." + name
+}
@@ -0,0 +1,45 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"time"
+)
+
+type Silence struct {
+	Id            uint                                  `gorm:"primaryKey;"`
+	TenantId      uuid.UUID                             `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';index"`
+	HealthCheckId *uint                                 `gorm:"index"`
+	Tag           *string                               `gorm:"size:100;index"`
+	Labels        datatypes.JSONType[map[string]string] `gorm:"not null;default:'{}'"`
+	Reason        string                                `gorm:"size:600;not null"`
+	CreatedBy     uuid.UUID                             `gorm:"type:uuid;not null"`
+	StartsAt      time.Time                             `gorm:"not null"`
+	EndsAt        time.Time                             `gorm:"not null;index"`
+	Base3
+}
+
+func NewSilence(tenantId uuid.UUID, healthCheckId *uint, tag *string, labels map[string]string, reason string, createdBy uuid.UUID, duration time.Duration) Silence {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	now := time.Now()
+	return Silence{
+		TenantId:      tenantId,
+		HealthCheckId: healthCheckId,
+		Tag:           tag,
+		Labels:        datatypes.NewJSONType(labels),
+		Reason:        reason,
+		CreatedBy:     createdBy,
+		StartsAt:      now,
+		EndsAt:        now.Add(duration),
+	}
+}
+
+func (r *Silence) IsActive(now time.Time) bool {
+	return !now.Before(r.StartsAt) && now.Before(r.EndsAt)
+}
+
+func (r *Silence) Expire() {
+	r.EndsAt = time.Now()
+}
@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+)
+
+// Tag is a name a tenant groups its health checks by, every tenant has its own set of tags.
+type Tag struct {
+	Id       uint      `gorm:"primaryKey;"`
+	TenantId uuid.UUID `gorm:"type:uuid;not null;default:'00000000-0000-0000-0000-000000000000';uniqueIndex:idx_tags_tenant_name,priority:1"`
+	Name     string    `gorm:"size:100;not null;uniqueIndex:idx_tags_tenant_name,priority:2"`
+	Base1
+}
+
+func NewTag(tenantId uuid.UUID, name string) Tag {
+	return Tag{
+		TenantId: tenantId,
+		Name:     name,
+	}
+}
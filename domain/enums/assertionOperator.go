@@ -0,0 +1,26 @@
+package enums
+
+type AssertionOperator string
+
+const (
+	AssertionOperatorEquals    AssertionOperator = "equals"
+	AssertionOperatorNotEquals AssertionOperator = "notEquals"
+	AssertionOperatorContains  AssertionOperator = "contains"
+	AssertionOperatorExists    AssertionOperator = "exists"
+)
+
+func (r AssertionOperator) String() string {
+	return string(r)
+}
+
+func (r AssertionOperator) IsValid() bool {
+	switch r {
+	case AssertionOperatorEquals,
+		AssertionOperatorNotEquals,
+		AssertionOperatorContains,
+		AssertionOperatorExists:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,26 @@
+package enums
+
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionStatus AuditAction = "status"
+	AuditActionDelete AuditAction = "delete"
+)
+
+func (r AuditAction) String() string {
+	return string(r)
+}
+
+func (r AuditAction) IsValid() bool {
+	switch r {
+	case AuditActionCreate,
+		AuditActionUpdate,
+		AuditActionStatus,
+		AuditActionDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,24 @@
+package enums
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending DeliveryStatus = "pending"
+	DeliveryStatusSent    DeliveryStatus = "sent"
+	DeliveryStatusDead    DeliveryStatus = "dead"
+)
+
+func (r DeliveryStatus) String() string {
+	return string(r)
+}
+
+func (r DeliveryStatus) IsValid() bool {
+	switch r {
+	case DeliveryStatusPending,
+		DeliveryStatusSent,
+		DeliveryStatusDead:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,33 @@
+package enums
+
+import "time"
+
+type DigestPeriod string
+
+const (
+	DigestPeriodDaily  DigestPeriod = "daily"
+	DigestPeriodWeekly DigestPeriod = "weekly"
+)
+
+func (r DigestPeriod) String() string {
+	return string(r)
+}
+
+func (r DigestPeriod) IsValid() bool {
+	switch r {
+	case DigestPeriodDaily,
+		DigestPeriodWeekly:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r DigestPeriod) Duration() time.Duration {
+	switch r {
+	case DigestPeriodWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
@@ -0,0 +1,28 @@
+package enums
+
+type DnsRecordType string
+
+const (
+	DnsRecordTypeA     DnsRecordType = "A"
+	DnsRecordTypeAAAA  DnsRecordType = "AAAA"
+	DnsRecordTypeCNAME DnsRecordType = "CNAME"
+	DnsRecordTypeMX    DnsRecordType = "MX"
+	DnsRecordTypeTXT   DnsRecordType = "TXT"
+)
+
+func (r DnsRecordType) String() string {
+	return string(r)
+}
+
+func (r DnsRecordType) IsValid() bool {
+	switch r {
+	case DnsRecordTypeA,
+		DnsRecordTypeAAAA,
+		DnsRecordTypeCNAME,
+		DnsRecordTypeMX,
+		DnsRecordTypeTXT:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,22 @@
+package enums
+
+type DocumentFormat string
+
+const (
+	DocumentFormatJson DocumentFormat = "json"
+	DocumentFormatYaml DocumentFormat = "yaml"
+)
+
+func (r DocumentFormat) String() string {
+	return string(r)
+}
+
+func (r DocumentFormat) IsValid() bool {
+	switch r {
+	case DocumentFormatJson,
+		DocumentFormatYaml:
+		return true
+	default:
+		return false
+	}
+}
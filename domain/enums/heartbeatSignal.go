@@ -0,0 +1,24 @@
+package enums
+
+type HeartbeatSignal string
+
+const (
+	HeartbeatSignalStart   HeartbeatSignal = "start"
+	HeartbeatSignalSuccess HeartbeatSignal = "success"
+	HeartbeatSignalFail    HeartbeatSignal = "fail"
+)
+
+func (r HeartbeatSignal) String() string {
+	return string(r)
+}
+
+func (r HeartbeatSignal) IsValid() bool {
+	switch r {
+	case HeartbeatSignalStart,
+		HeartbeatSignalSuccess,
+		HeartbeatSignalFail:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,26 @@
+package enums
+
+type HttpStepSource string
+
+const (
+	HttpStepSourceStatus   HttpStepSource = "status"
+	HttpStepSourceHeader   HttpStepSource = "header"
+	HttpStepSourceBody     HttpStepSource = "body"
+	HttpStepSourceJsonPath HttpStepSource = "jsonPath"
+)
+
+func (r HttpStepSource) String() string {
+	return string(r)
+}
+
+func (r HttpStepSource) IsValid() bool {
+	switch r {
+	case HttpStepSourceStatus,
+		HttpStepSourceHeader,
+		HttpStepSourceBody,
+		HttpStepSourceJsonPath:
+		return true
+	default:
+		return false
+	}
+}
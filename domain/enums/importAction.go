@@ -0,0 +1,28 @@
+package enums
+
+type ImportAction string
+
+const (
+	ImportActionCreate    ImportAction = "create"
+	ImportActionUpdate    ImportAction = "update"
+	ImportActionDelete    ImportAction = "delete"
+	ImportActionStop      ImportAction = "stop"
+	ImportActionUnchanged ImportAction = "unchanged"
+)
+
+func (r ImportAction) String() string {
+	return string(r)
+}
+
+func (r ImportAction) IsValid() bool {
+	switch r {
+	case ImportActionCreate,
+		ImportActionUpdate,
+		ImportActionDelete,
+		ImportActionStop,
+		ImportActionUnchanged:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,24 @@
+package enums
+
+type IncidentStatus string
+
+const (
+	IncidentStatusOpen         IncidentStatus = "open"
+	IncidentStatusAcknowledged IncidentStatus = "acknowledged"
+	IncidentStatusResolved     IncidentStatus = "resolved"
+)
+
+func (r IncidentStatus) String() string {
+	return string(r)
+}
+
+func (r IncidentStatus) IsValid() bool {
+	switch r {
+	case IncidentStatusOpen,
+		IncidentStatusAcknowledged,
+		IncidentStatusResolved:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,22 @@
+package enums
+
+type NotificationProvider string
+
+const (
+	NotificationProviderDiscord NotificationProvider = "discord"
+	NotificationProviderSlack   NotificationProvider = "slack"
+)
+
+func (r NotificationProvider) String() string {
+	return string(r)
+}
+
+func (r NotificationProvider) IsValid() bool {
+	switch r {
+	case NotificationProviderDiscord,
+		NotificationProviderSlack:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,36 @@
+package enums
+
+type Permission string
+
+const (
+	PermissionList           Permission = "list"
+	PermissionCreate         Permission = "create"
+	PermissionUpdate         Permission = "update"
+	PermissionDelete         Permission = "delete"
+	PermissionStatus         Permission = "status"
+	PermissionManageChannels Permission = "manageChannels"
+	PermissionManageRoles    Permission = "manageRoles"
+	PermissionManageApiKeys  Permission = "manageApiKeys"
+	PermissionManageSecrets  Permission = "manageSecrets"
+)
+
+func (r Permission) String() string {
+	return string(r)
+}
+
+func (r Permission) IsValid() bool {
+	switch r {
+	case PermissionList,
+		PermissionCreate,
+		PermissionUpdate,
+		PermissionDelete,
+		PermissionStatus,
+		PermissionManageChannels,
+		PermissionManageRoles,
+		PermissionManageApiKeys,
+		PermissionManageSecrets:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,32 @@
+package enums
+
+type ProbeType string
+
+const (
+	ProbeTypeHttp      ProbeType = "http"
+	ProbeTypeGrpc      ProbeType = "grpc"
+	ProbeTypeDns       ProbeType = "dns"
+	ProbeTypePostgres  ProbeType = "postgres"
+	ProbeTypeRedis     ProbeType = "redis"
+	ProbeTypeHeartbeat ProbeType = "heartbeat"
+	ProbeTypeMultiStep ProbeType = "multiStep"
+)
+
+func (r ProbeType) String() string {
+	return string(r)
+}
+
+func (r ProbeType) IsValid() bool {
+	switch r {
+	case ProbeTypeHttp,
+		ProbeTypeGrpc,
+		ProbeTypeDns,
+		ProbeTypePostgres,
+		ProbeTypeRedis,
+		ProbeTypeHeartbeat,
+		ProbeTypeMultiStep:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,29 @@
+package enums
+
+type PruneMode string
+
+const (
+	PruneModeDelete PruneMode = "delete"
+	PruneModeStop   PruneMode = "stop"
+)
+
+func (r PruneMode) String() string {
+	return string(r)
+}
+
+func (r PruneMode) IsValid() bool {
+	switch r {
+	case PruneModeDelete,
+		PruneModeStop:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r PruneMode) List() []string {
+	return []string{
+		PruneModeDelete.String(),
+		PruneModeStop.String(),
+	}
+}
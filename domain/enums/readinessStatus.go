@@ -0,0 +1,24 @@
+package enums
+
+type ReadinessStatus string
+
+const (
+	ReadinessStatusUp           ReadinessStatus = "up"
+	ReadinessStatusDown         ReadinessStatus = "down"
+	ReadinessStatusShuttingDown ReadinessStatus = "shuttingDown"
+)
+
+func (r ReadinessStatus) String() string {
+	return string(r)
+}
+
+func (r ReadinessStatus) IsValid() bool {
+	switch r {
+	case ReadinessStatusUp,
+		ReadinessStatusDown,
+		ReadinessStatusShuttingDown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,37 @@
+package enums
+
+type Role string
+
+const (
+	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleViewer,
+		RoleEditor,
+		RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r Role) Allows(permission Permission) bool {
+	switch r {
+	case RoleAdmin:
+		return true
+	case RoleEditor:
+		return permission != PermissionManageChannels && permission != PermissionManageRoles && permission != PermissionManageApiKeys && permission != PermissionManageSecrets
+	case RoleViewer:
+		return permission == PermissionList
+	default:
+		return false
+	}
+}
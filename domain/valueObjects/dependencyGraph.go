@@ -0,0 +1,19 @@
+package valueObjects
+
+type DependencyGraph struct {
+	Nodes []DependencyNode
+	Edges []DependencyEdge
+}
+
+type DependencyNode struct {
+	HealthCheckId uint
+	Url           string
+	Down          bool
+	Unreachable   bool
+}
+
+type DependencyEdge struct {
+	Id            uint
+	HealthCheckId uint
+	ParentId      uint
+}
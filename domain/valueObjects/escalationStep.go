@@ -0,0 +1,16 @@
+package valueObjects
+
+import (
+	"time"
+)
+
+type EscalationStep struct {
+	NotificationChannelId uint
+	DelayMinute           uint
+}
+
+type IncidentEscalation struct {
+	Step                  uint
+	NotificationChannelId uint
+	EscalatedAt           time.Time
+}
@@ -0,0 +1,43 @@
+package valueObjects
+
+import "health-check/domain/enums"
+
+type HealthCheckDefinition struct {
+	Name             string            `json:"name" yaml:"name"`
+	Description      string            `json:"description,omitempty" yaml:"description,omitempty"`
+	RunbookUrl       string            `json:"runbookUrl,omitempty" yaml:"runbookUrl,omitempty"`
+	Owner            string            `json:"owner,omitempty" yaml:"owner,omitempty"`
+	Interval         string            `json:"interval" yaml:"interval"`
+	Url              string            `json:"url" yaml:"url"`
+	Method           enums.HttpMethod  `json:"method" yaml:"method"`
+	Headers          map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body             map[string]any    `json:"body,omitempty" yaml:"body,omitempty"`
+	Type             enums.ProbeType   `json:"type,omitempty" yaml:"type,omitempty"`
+	Settings         ProbeSettings     `json:"settings" yaml:"settings,omitempty"`
+	EscalationPolicy string            `json:"escalationPolicy,omitempty" yaml:"escalationPolicy,omitempty"`
+	Tags             []string          `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+func (r HealthCheckDefinition) IsValid() bool {
+	return r.Name != "" && r.Interval != "" && r.Settings.IsValid(r.ProbeType(), r.Url, r.Method)
+}
+
+// ProbeType returns the type of the definition, documents written before probe types existed are http checks.
+func (r HealthCheckDefinition) ProbeType() enums.ProbeType {
+	if r.Type == "" {
+		return enums.ProbeTypeHttp
+	}
+	return r.Type
+}
+
+type HealthCheckImportChange struct {
+	Id     uint
+	Owner  string
+	Name   string
+	Action enums.ImportAction
+}
+
+type HealthCheckDocument struct {
+	HealthChecks []HealthCheckDefinition `json:"healthChecks" yaml:"healthChecks"`
+}
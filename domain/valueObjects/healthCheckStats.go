@@ -0,0 +1,8 @@
+package valueObjects
+
+type HealthCheckStats struct {
+	HealthCheckId              uint
+	Total                      int64
+	Succeeded                  int64
+	AverageDurationMillisecond float64
+}
@@ -0,0 +1,251 @@
+package valueObjects
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"health-check/domain/enums"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const maxHttpSteps = 10
+
+var variableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// MultiStepProbeSettings holds the http requests of a multi step health check, they run in order and the url of the
+// health check is the base relative step urls are resolved against.
+type MultiStepProbeSettings struct {
+	Steps []HttpStep `json:"steps" yaml:"steps"`
+}
+
+// HttpStep is one request of a multi step health check, its url, header values and body strings are templates reading
+// the variables extracted by the previous steps.
+type HttpStep struct {
+	Name       string               `json:"name,omitempty" yaml:"name,omitempty"`
+	Method     enums.HttpMethod     `json:"method" yaml:"method"`
+	Url        string               `json:"url" yaml:"url"`
+	Headers    map[string]string    `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       map[string]any       `json:"body,omitempty" yaml:"body,omitempty"`
+	Assertions []HttpStepAssertion  `json:"assertions,omitempty" yaml:"assertions,omitempty"`
+	Extract    []HttpStepExtraction `json:"extract,omitempty" yaml:"extract,omitempty"`
+}
+
+// HttpStepAssertion compares a value of the response with the expected one, path is the header name or the json path.
+type HttpStepAssertion struct {
+	Source   enums.HttpStepSource    `json:"source" yaml:"source"`
+	Path     string                  `json:"path,omitempty" yaml:"path,omitempty"`
+	Operator enums.AssertionOperator `json:"operator" yaml:"operator"`
+	Value    string                  `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// HttpStepExtraction stores a value of the response in a variable the next steps can use.
+type HttpStepExtraction struct {
+	Variable string               `json:"variable" yaml:"variable"`
+	Source   enums.HttpStepSource `json:"source" yaml:"source"`
+	Path     string               `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+// HttpStepResponse is what a step got back, the body is decoded as json once the first json path reads it.
+type HttpStepResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+
+	document any
+	decoded  bool
+}
+
+func (r MultiStepProbeSettings) isValid() bool {
+	if len(r.Steps) == 0 || len(r.Steps) > maxHttpSteps {
+		return false
+	}
+	for _, step := range r.Steps {
+		if !step.isValid() {
+			return false
+		}
+	}
+	return true
+}
+
+func (r HttpStep) isValid() bool {
+	if r.Url == "" || !r.Method.IsValid() || len(r.Name) > 100 {
+		return false
+	}
+	for _, assertion := range r.Assertions {
+		if !assertion.Operator.IsValid() || !validStepSource(assertion.Source, assertion.Path) {
+			return false
+		}
+	}
+	for _, extraction := range r.Extract {
+		if !variableName.MatchString(extraction.Variable) || !validStepSource(extraction.Source, extraction.Path) {
+			return false
+		}
+	}
+	return true
+}
+
+func validStepSource(source enums.HttpStepSource, path string) bool {
+	switch source {
+	case enums.HttpStepSourceHeader:
+		return path != ""
+	case enums.HttpStepSourceJsonPath:
+		return strings.HasPrefix(path, "$")
+	default:
+		return source.IsValid()
+	}
+}
+
+// Check runs the assertions of the step against its response, a step without a status assertion expects a 2xx status.
+func (r HttpStep) Check(response *HttpStepResponse) error {
+	hasStatusAssertion := false
+	for _, assertion := range r.Assertions {
+		hasStatusAssertion = hasStatusAssertion || assertion.Source == enums.HttpStepSourceStatus
+	}
+	if !hasStatusAssertion && (response.StatusCode < 200 || response.StatusCode > 299) {
+		return fmt.Errorf("got status %d, expected 2xx", response.StatusCode)
+	}
+
+	for _, assertion := range r.Assertions {
+		if err := assertion.assert(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Variables returns the values the step extracts from its response, a value that is not found fails the step.
+func (r HttpStep) Variables(response *HttpStepResponse) (map[string]string, error) {
+	variables := make(map[string]string, len(r.Extract))
+	for _, extraction := range r.Extract {
+		value, found, err := response.value(extraction.Source, extraction.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("no value at %s for variable %s", describeStepSource(extraction.Source, extraction.Path), extraction.Variable)
+		}
+		variables[extraction.Variable] = value
+	}
+	return variables, nil
+}
+
+func (r HttpStepAssertion) assert(response *HttpStepResponse) error {
+	value, found, err := response.value(r.Source, r.Path)
+	if err != nil {
+		return err
+	}
+
+	source := describeStepSource(r.Source, r.Path)
+	switch r.Operator {
+	case enums.AssertionOperatorExists:
+		if !found {
+			return fmt.Errorf("%s does not exist", source)
+		}
+	case enums.AssertionOperatorEquals:
+		if !found || value != r.Value {
+			return fmt.Errorf("%s: got %q, expected %q", source, value, r.Value)
+		}
+	case enums.AssertionOperatorNotEquals:
+		if found && value == r.Value {
+			return fmt.Errorf("%s: got %q, expected another value", source, value)
+		}
+	case enums.AssertionOperatorContains:
+		if !strings.Contains(value, r.Value) {
+			return fmt.Errorf("%s: %q does not contain %q", source, value, r.Value)
+		}
+	}
+	return nil
+}
+
+func describeStepSource(source enums.HttpStepSource, path string) string {
+	if path == "" {
+		return source.String()
+	}
+	return source.String() + " " + path
+}
+
+// value reads the response at the source, found is false when the header or the json path is missing.
+func (r *HttpStepResponse) value(source enums.HttpStepSource, path string) (value string, found bool, err error) {
+	switch source {
+	case enums.HttpStepSourceStatus:
+		return strconv.Itoa(r.StatusCode), true, nil
+	case enums.HttpStepSourceHeader:
+		values := r.Header.Values(path)
+		if len(values) == 0 {
+			return "", false, nil
+		}
+		return values[0], true, nil
+	case enums.HttpStepSourceJsonPath:
+		if !r.decoded {
+			if err = json.Unmarshal([]byte(r.Body), &r.document); err != nil {
+				return "", false, errors.New("response body is not json")
+			}
+			r.decoded = true
+		}
+		result, ok := jsonPath(r.document, path)
+		if !ok {
+			return "", false, nil
+		}
+		if text, isText := result.(string); isText {
+			return text, true, nil
+		}
+		data, _ := json.Marshal(result)
+		return string(data), true, nil
+	default:
+		return r.Body, true, nil
+	}
+}
+
+// jsonPath reads the value at a path like $.data.items[0].id from a decoded json document, only child keys and array
+// indexes are supported.
+func jsonPath(document any, path string) (any, bool) {
+	path = strings.TrimPrefix(path, "$")
+	current := document
+	for path != "" {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end == -1 {
+				end = len(path)
+			}
+			object, ok := current.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if current, ok = object[path[:end]]; !ok {
+				return nil, false
+			}
+			path = path[end:]
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end == -1 {
+				return nil, false
+			}
+			segment := path[1:end]
+			path = path[end+1:]
+			if len(segment) >= 2 && (segment[0] == '\'' || segment[0] == '"') && segment[len(segment)-1] == segment[0] {
+				object, ok := current.(map[string]any)
+				if !ok {
+					return nil, false
+				}
+				if current, ok = object[segment[1:len(segment)-1]]; !ok {
+					return nil, false
+				}
+				continue
+			}
+			index, err := strconv.Atoi(segment)
+			array, ok := current.([]any)
+			if err != nil || !ok || index < 0 || index >= len(array) {
+				return nil, false
+			}
+			current = array[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
@@ -0,0 +1,257 @@
+package valueObjects
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// ProbeSettings holds the options of the probe types other than http, only the one matching the type of the health check is read.
+// Secrets are read by the request templates of http and multi step health checks, they are stored sealed. The url, the
+// headers and the body of an http health check are expanded only when Templates is set, so checks stored before
+// templates existed keep sending a literal {{ as is. The steps of a multi step health check are always expanded.
+type ProbeSettings struct {
+	Templates bool                    `json:"templates,omitempty" yaml:"templates,omitempty"`
+	Secrets   map[string]string       `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+	Grpc      *GrpcProbeSettings      `json:"grpc,omitempty" yaml:"grpc,omitempty"`
+	Dns       *DnsProbeSettings       `json:"dns,omitempty" yaml:"dns,omitempty"`
+	Postgres  *PostgresProbeSettings  `json:"postgres,omitempty" yaml:"postgres,omitempty"`
+	Redis     *RedisProbeSettings     `json:"redis,omitempty" yaml:"redis,omitempty"`
+	Heartbeat *HeartbeatProbeSettings `json:"heartbeat,omitempty" yaml:"heartbeat,omitempty"`
+	MultiStep *MultiStepProbeSettings `json:"multiStep,omitempty" yaml:"multiStep,omitempty"`
+}
+
+type GrpcProbeSettings struct {
+	Service            string            `json:"service,omitempty" yaml:"service,omitempty"`
+	Tls                bool              `json:"tls,omitempty" yaml:"tls,omitempty"`
+	InsecureSkipVerify bool              `json:"insecureSkipVerify,omitempty" yaml:"insecureSkipVerify,omitempty"`
+	ServerName         string            `json:"serverName,omitempty" yaml:"serverName,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	TimeoutSecond      uint              `json:"timeoutSecond,omitempty" yaml:"timeoutSecond,omitempty"`
+}
+
+type DnsProbeSettings struct {
+	RecordType     enums.DnsRecordType `json:"recordType,omitempty" yaml:"recordType,omitempty"`
+	Resolver       string              `json:"resolver,omitempty" yaml:"resolver,omitempty"`
+	ExpectedValues []string            `json:"expectedValues,omitempty" yaml:"expectedValues,omitempty"`
+	Contains       string              `json:"contains,omitempty" yaml:"contains,omitempty"`
+	MinRecords     uint                `json:"minRecords,omitempty" yaml:"minRecords,omitempty"`
+	TimeoutSecond  uint                `json:"timeoutSecond,omitempty" yaml:"timeoutSecond,omitempty"`
+}
+
+// PostgresProbeSettings holds the credentials kept out of the target dsn, the password is stored sealed.
+type PostgresProbeSettings struct {
+	Username      string `json:"username,omitempty" yaml:"username,omitempty"`
+	Password      string `json:"password,omitempty" yaml:"password,omitempty"`
+	Query         string `json:"query,omitempty" yaml:"query,omitempty"`
+	Expected      string `json:"expected,omitempty" yaml:"expected,omitempty"`
+	TimeoutSecond uint   `json:"timeoutSecond,omitempty" yaml:"timeoutSecond,omitempty"`
+}
+
+// RedisProbeSettings holds the credentials kept out of the target url, the password is stored sealed.
+type RedisProbeSettings struct {
+	Username      string   `json:"username,omitempty" yaml:"username,omitempty"`
+	Password      string   `json:"password,omitempty" yaml:"password,omitempty"`
+	Command       []string `json:"command,omitempty" yaml:"command,omitempty"`
+	Expected      string   `json:"expected,omitempty" yaml:"expected,omitempty"`
+	TimeoutSecond uint     `json:"timeoutSecond,omitempty" yaml:"timeoutSecond,omitempty"`
+}
+
+// HeartbeatProbeSettings holds how long past its interval a heartbeat check waits for a ping before going down.
+type HeartbeatProbeSettings struct {
+	GraceSecond uint `json:"graceSecond,omitempty" yaml:"graceSecond,omitempty"`
+}
+
+// IsValid checks the target and the settings against the probe type, target is the url of the health check.
+func (r ProbeSettings) IsValid(probeType enums.ProbeType, target string, method enums.HttpMethod) bool {
+	for name, value := range r.Secrets {
+		if !variableName.MatchString(name) || value == "" {
+			return false
+		}
+	}
+
+	switch probeType {
+	case enums.ProbeTypeHttp:
+		parsed, err := url.Parse(target)
+		return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != "" && method.IsValid()
+	case enums.ProbeTypeGrpc:
+		return target != ""
+	case enums.ProbeTypeDns:
+		return target != "" && (r.Dns == nil || r.Dns.isValid())
+	case enums.ProbeTypePostgres:
+		return validDatastoreUrl(target, "postgres", "postgresql")
+	case enums.ProbeTypeHeartbeat:
+		return true
+	case enums.ProbeTypeMultiStep:
+		parsed, err := url.Parse(target)
+		return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != "" && r.MultiStep != nil && r.MultiStep.isValid()
+	case enums.ProbeTypeRedis:
+		return validDatastoreUrl(target, "redis", "rediss") && (r.Redis == nil || r.Redis.Command == nil || len(r.Redis.Command) != 0 && r.Redis.Command[0] != "")
+	default:
+		return false
+	}
+}
+
+// GrpcOrDefault returns the grpc settings, a health check without them probes the overall server health in plaintext.
+func (r ProbeSettings) GrpcOrDefault() GrpcProbeSettings {
+	if r.Grpc == nil {
+		return GrpcProbeSettings{}
+	}
+	return *r.Grpc
+}
+
+// DnsOrDefault returns the dns settings, a health check without them only asserts the hostname has an A record.
+func (r ProbeSettings) DnsOrDefault() DnsProbeSettings {
+	if r.Dns == nil {
+		return DnsProbeSettings{RecordType: enums.DnsRecordTypeA}
+	}
+	settings := *r.Dns
+	if settings.RecordType == "" {
+		settings.RecordType = enums.DnsRecordTypeA
+	}
+	return settings
+}
+
+func (r DnsProbeSettings) isValid() bool {
+	if r.RecordType != "" && !r.RecordType.IsValid() {
+		return false
+	}
+	if r.RecordType == "" || r.RecordType == enums.DnsRecordTypeA || r.RecordType == enums.DnsRecordTypeAAAA {
+		for _, value := range r.ExpectedValues {
+			if net.ParseIP(value) == nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Assert checks the records of a lookup against the expectations of the settings and returns the first one not met.
+func (r DnsProbeSettings) Assert(records []string) error {
+	if len(records) < int(r.MinRecords) {
+		return fmt.Errorf("got %d records, expected at least %d", len(records), r.MinRecords)
+	}
+
+	if len(r.ExpectedValues) != 0 {
+		got := make([]string, 0, len(records))
+		for _, record := range records {
+			got = append(got, r.normalize(record))
+		}
+		expected := make([]string, 0, len(r.ExpectedValues))
+		for _, value := range r.ExpectedValues {
+			expected = append(expected, r.normalize(value))
+		}
+		slices.Sort(got)
+		slices.Sort(expected)
+		if !slices.Equal(slices.Compact(got), slices.Compact(expected)) {
+			return fmt.Errorf("got records %v, expected %v", records, r.ExpectedValues)
+		}
+	}
+
+	if r.Contains != "" && !slices.ContainsFunc(records, func(record string) bool {
+		return strings.Contains(record, r.Contains)
+	}) {
+		return fmt.Errorf("no record contains %q", r.Contains)
+	}
+
+	return nil
+}
+
+// normalize makes records comparable regardless of how the ip is written or whether the name is fully qualified.
+func (r DnsProbeSettings) normalize(record string) string {
+	switch r.RecordType {
+	case enums.DnsRecordTypeTXT:
+		return record
+	case enums.DnsRecordTypeA, enums.DnsRecordTypeAAAA:
+		if ip := net.ParseIP(record); ip != nil {
+			return ip.String()
+		}
+	}
+	return strings.ToLower(strings.TrimSuffix(record, "."))
+}
+
+// PostgresOrDefault returns the postgres settings with the query defaulting to SELECT 1.
+func (r ProbeSettings) PostgresOrDefault() PostgresProbeSettings {
+	var settings PostgresProbeSettings
+	if r.Postgres != nil {
+		settings = *r.Postgres
+	}
+	if settings.Query == "" {
+		settings.Query = "SELECT 1"
+	}
+	return settings
+}
+
+// RedisOrDefault returns the redis settings with the command defaulting to PING.
+func (r ProbeSettings) RedisOrDefault() RedisProbeSettings {
+	var settings RedisProbeSettings
+	if r.Redis != nil {
+		settings = *r.Redis
+	}
+	if len(settings.Command) == 0 {
+		settings.Command = []string{"PING"}
+	}
+	return settings
+}
+
+// HeartbeatOrDefault returns the heartbeat settings, a health check without them has no grace time.
+func (r ProbeSettings) HeartbeatOrDefault() HeartbeatProbeSettings {
+	if r.Heartbeat == nil {
+		return HeartbeatProbeSettings{}
+	}
+	return *r.Heartbeat
+}
+
+// Seal returns a copy of the settings with every credential passed through encrypt for the tenant.
+func (r ProbeSettings) Seal(tenantId uuid.UUID, encrypt func(tenantId uuid.UUID, field string, value string) (string, error)) (ProbeSettings, error) {
+	return r.credentials(tenantId, encrypt)
+}
+
+// Open returns a copy of the settings with every credential passed through decrypt for the tenant.
+func (r ProbeSettings) Open(tenantId uuid.UUID, decrypt func(tenantId uuid.UUID, field string, value string) (string, error)) (ProbeSettings, error) {
+	return r.credentials(tenantId, decrypt)
+}
+
+// credentials passes every credential through transform along with the field it is stored in, so a credential sealed
+// for one field does not open in another.
+func (r ProbeSettings) credentials(tenantId uuid.UUID, transform func(tenantId uuid.UUID, field string, value string) (string, error)) (ProbeSettings, error) {
+	var err error
+	if r.Postgres != nil {
+		postgres := *r.Postgres
+		if postgres.Password, err = transform(tenantId, "settings.postgres.password", postgres.Password); err != nil {
+			return r, err
+		}
+		r.Postgres = &postgres
+	}
+	if r.Redis != nil {
+		redis := *r.Redis
+		if redis.Password, err = transform(tenantId, "settings.redis.password", redis.Password); err != nil {
+			return r, err
+		}
+		r.Redis = &redis
+	}
+	if r.Secrets != nil {
+		secrets := make(map[string]string, len(r.Secrets))
+		for name, value := range r.Secrets {
+			if secrets[name], err = transform(tenantId, "settings.secrets."+name, value); err != nil {
+				return r, err
+			}
+		}
+		r.Secrets = secrets
+	}
+	return r, nil
+}
+
+// validDatastoreUrl accepts a url of one of the schemes with a host, the password belongs in the settings so it can be sealed.
+func validDatastoreUrl(target string, schemes ...string) bool {
+	parsed, err := url.Parse(target)
+	if err != nil || parsed.Host == "" || !slices.Contains(schemes, parsed.Scheme) {
+		return false
+	}
+	_, hasPassword := parsed.User.Password()
+	return !hasPassword
+}
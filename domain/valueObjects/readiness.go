@@ -0,0 +1,17 @@
+package valueObjects
+
+import "health-check/domain/enums"
+
+type ComponentReadiness struct {
+	Status enums.ReadinessStatus
+	Error  string `json:",omitempty"`
+}
+
+type Readiness struct {
+	Status     enums.ReadinessStatus
+	Components map[string]ComponentReadiness
+}
+
+func (r Readiness) IsReady() bool {
+	return r.Status == enums.ReadinessStatusUp
+}
@@ -0,0 +1,130 @@
+package valueObjects
+
+import (
+	"regexp"
+	"strings"
+)
+
+const redacted = "***"
+
+var templateAction = regexp.MustCompile(`{{.*?}}`)
+
+// RedactHeaders returns a copy of the headers safe to hand back to a client, the literal text of every value is
+// replaced and only the template actions, like {{ secret "token" }}, are kept so the secret references stay visible.
+func RedactHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+	result := make(map[string]string, len(headers))
+	for key, value := range headers {
+		result[key] = redact(value)
+	}
+	return result
+}
+
+// RedactBody returns a copy of the body with every string redacted the way RedactHeaders does it.
+func RedactBody(body map[string]any) map[string]any {
+	if body == nil {
+		return nil
+	}
+	result, _ := redactValue(body).(map[string]any)
+	return result
+}
+
+// RestoreHeaders puts the stored value back for every header a client sent back redacted, so a check read and
+// written back unchanged keeps its credentials.
+func RestoreHeaders(stored map[string]string, headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+	result := make(map[string]string, len(headers))
+	for key, value := range headers {
+		if storedValue, ok := stored[key]; ok && value != storedValue && value == redact(storedValue) {
+			value = storedValue
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// RestoreBody puts the stored value back for every string of the body a client sent back redacted.
+func RestoreBody(stored map[string]any, body map[string]any) map[string]any {
+	if body == nil {
+		return nil
+	}
+	result, _ := restoreValue(stored, body).(map[string]any)
+	return result
+}
+
+func redactValue(value any) any {
+	switch value := value.(type) {
+	case string:
+		return redact(value)
+	case map[string]any:
+		result := make(map[string]any, len(value))
+		for key, item := range value {
+			result[key] = redactValue(item)
+		}
+		return result
+	case []any:
+		result := make([]any, 0, len(value))
+		for _, item := range value {
+			result = append(result, redactValue(item))
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func restoreValue(stored any, value any) any {
+	switch value := value.(type) {
+	case string:
+		if storedValue, ok := stored.(string); ok && value != storedValue && value == redact(storedValue) {
+			return storedValue
+		}
+		return value
+	case map[string]any:
+		storedMap, _ := stored.(map[string]any)
+		result := make(map[string]any, len(value))
+		for key, item := range value {
+			result[key] = restoreValue(storedMap[key], item)
+		}
+		return result
+	case []any:
+		storedArray, _ := stored.([]any)
+		result := make([]any, 0, len(value))
+		for i, item := range value {
+			var storedItem any
+			if i < len(storedArray) {
+				storedItem = storedArray[i]
+			}
+			result = append(result, restoreValue(storedItem, item))
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// redact replaces the literal text around the template actions of a value and keeps its surrounding whitespace.
+func redact(value string) string {
+	var builder strings.Builder
+	last := 0
+	for _, action := range templateAction.FindAllStringIndex(value, -1) {
+		builder.WriteString(redactLiteral(value[last:action[0]]))
+		builder.WriteString(value[action[0]:action[1]])
+		last = action[1]
+	}
+	builder.WriteString(redactLiteral(value[last:]))
+	return builder.String()
+}
+
+func redactLiteral(literal string) string {
+	trimmed := strings.TrimSpace(literal)
+	if trimmed == "" {
+		return literal
+	}
+	start := strings.Index(literal, trimmed)
+	return literal[:start] + redacted + literal[start+len(trimmed):]
+}
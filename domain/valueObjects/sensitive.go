@@ -0,0 +1,49 @@
+package valueObjects
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const sensitiveMask = "***"
+
+// Sensitive holds the secret values a request was rendered with, so they can be masked wherever the request or its
+// answer ends up.
+type Sensitive []string
+
+// Mask replaces every secret value in the text, the longer values first so a secret containing another is masked whole.
+func (r Sensitive) Mask(text string) string {
+	if len(r) == 0 {
+		return text
+	}
+
+	values := slices.Clone(r)
+	slices.SortFunc(values, func(a, b string) int {
+		return cmp.Compare(len(b), len(a))
+	})
+
+	oldNew := make([]string, 0, len(values)*2)
+	for _, value := range values {
+		if value != "" {
+			oldNew = append(oldNew, value, sensitiveMask)
+		}
+	}
+	return strings.NewReplacer(oldNew...).Replace(text)
+}
+
+// MaskHeader returns a copy of the header with every secret value masked.
+func (r Sensitive) MaskHeader(header http.Header) http.Header {
+	if len(r) == 0 || header == nil {
+		return header
+	}
+
+	masked := make(http.Header, len(header))
+	for key, values := range header {
+		for _, value := range values {
+			masked[key] = append(masked[key], r.Mask(value))
+		}
+	}
+	return masked
+}
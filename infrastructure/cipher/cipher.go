@@ -0,0 +1,166 @@
+package cipher
+
+import (
+	"crypto/aes"
+	stdCipher "crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"health-check/application/interfaces"
+	"strings"
+)
+
+const prefix = "enc:"
+
+type sKey struct {
+	id   string
+	aead stdCipher.AEAD
+}
+
+type sCipher struct {
+	current sKey
+	keys    []sKey
+}
+
+// NewCipher seals with the key and still opens the values sealed with the previous keys, so the key can be rotated
+// by moving it to the previous keys until every value is sealed again.
+func NewCipher(key string, previousKeys []string) (interfaces.ICipher, error) {
+	current, err := newKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []sKey{current}
+	for _, previousKey := range previousKeys {
+		var previous sKey
+		if previous, err = newKey(previousKey); err != nil {
+			return nil, fmt.Errorf("previous key: %w", err)
+		}
+		keys = append(keys, previous)
+	}
+
+	return &sCipher{
+		current: current,
+		keys:    keys,
+	}, nil
+}
+
+func newKey(key string) (sKey, error) {
+	decodedKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return sKey{}, fmt.Errorf("error in decode encryption key: %w", err)
+	}
+
+	if len(decodedKey) != 32 {
+		return sKey{}, errors.New("encryption key must be the base64 of 32 bytes")
+	}
+
+	block, err := aes.NewCipher(decodedKey)
+	if err != nil {
+		return sKey{}, err
+	}
+
+	aead, err := stdCipher.NewGCM(block)
+	if err != nil {
+		return sKey{}, err
+	}
+
+	hash := sha256.Sum256(decodedKey)
+	return sKey{
+		id:   hex.EncodeToString(hash[:4]),
+		aead: aead,
+	}, nil
+}
+
+// additionalData binds a sealed value to the tenant and the field it was sealed for, so a value copied to another
+// tenant or another field does not open.
+func additionalData(tenantId uuid.UUID, field string) []byte {
+	return []byte(tenantId.String() + ":" + field)
+}
+
+// Encrypt seals the value with aes-gcm and the current key for the field of the tenant, a value carrying the prefix is
+// sealed like any other plaintext so a caller can never hand in ciphertext that gets stored as is.
+func (r *sCipher) Encrypt(tenantId uuid.UUID, field string, value string) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+
+	nonce := make([]byte, r.current.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return prefix + r.current.id + ":" + base64.StdEncoding.EncodeToString(r.current.aead.Seal(nonce, nonce, []byte(value), additionalData(tenantId, field))), nil
+}
+
+// Decrypt opens a value sealed by Encrypt for the same field of the same tenant with any of the keys, a value without
+// the prefix is rejected since the rotation job seals every plaintext value on start.
+func (r *sCipher) Decrypt(tenantId uuid.UUID, field string, value string) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+
+	if !strings.HasPrefix(value, prefix) {
+		return "", errors.New("value is not sealed")
+	}
+
+	keyId, encoded := "", strings.TrimPrefix(value, prefix)
+	if index := strings.IndexByte(encoded, ':'); index != -1 {
+		keyId, encoded = encoded[:index], encoded[index+1:]
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	// values sealed before the key id was stored are tried with every key
+	err = fmt.Errorf("no encryption key with id %s", keyId)
+	for _, key := range r.keys {
+		if keyId != "" && key.id != keyId {
+			continue
+		}
+		var opened string
+		if opened, err = open(key.aead, sealed, additionalData(tenantId, field)); err == nil {
+			return opened, nil
+		}
+	}
+
+	return "", err
+}
+
+// Rotate seals the value again with the current key, a value already sealed with it is returned as is and a plaintext
+// value stored before the credentials were sealed is sealed for the first time.
+func (r *sCipher) Rotate(tenantId uuid.UUID, field string, value string) (string, error) {
+	if !strings.HasPrefix(value, prefix) {
+		return r.Encrypt(tenantId, field, value)
+	}
+
+	if strings.HasPrefix(value, prefix+r.current.id+":") {
+		return value, nil
+	}
+
+	opened, err := r.Decrypt(tenantId, field, value)
+	if err != nil {
+		return "", err
+	}
+
+	return r.Encrypt(tenantId, field, opened)
+}
+
+func open(aead stdCipher.AEAD, sealed []byte, additionalData []byte) (string, error) {
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("sealed value is too short")
+	}
+
+	opened, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], additionalData)
+	if err != nil {
+		return "", err
+	}
+
+	return string(opened), nil
+}
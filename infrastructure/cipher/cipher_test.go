@@ -0,0 +1,136 @@
+package cipher
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const (
+	key        = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	rotatedKey = "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+	field      = "settings.secrets.token"
+)
+
+func newCipher(t *testing.T, key string, previousKeys []string) *sCipher {
+	iCipher, err := NewCipher(key, previousKeys)
+	assert.NoError(t, err)
+	return iCipher.(*sCipher)
+}
+
+func TestNewCipher(t *testing.T) {
+	tableTests := []struct {
+		name         string
+		key          string
+		previousKeys []string
+		err          bool
+	}{
+		{
+			name: "key is not base64",
+			key:  "not base64!",
+			err:  true,
+		},
+		{
+			name: "key is not 32 bytes",
+			key:  "c2hvcnQ=",
+			err:  true,
+		},
+		{
+			name:         "previous key is not 32 bytes",
+			key:          key,
+			previousKeys: []string{"c2hvcnQ="},
+			err:          true,
+		},
+		{
+			name:         "valid keys",
+			key:          rotatedKey,
+			previousKeys: []string{key},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			iCipher, err := NewCipher(tableTest.key, tableTest.previousKeys)
+
+			assert.Equal(t, tableTest.err, err != nil)
+			assert.Equal(t, tableTest.err, iCipher == nil)
+		})
+	}
+}
+
+func TestCipher(t *testing.T) {
+	tenantId := uuid.New()
+	iCipher := newCipher(t, key, nil)
+
+	sealed, err := iCipher.Encrypt(tenantId, field, "secret")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, "enc:"))
+	assert.NotContains(t, sealed, "secret")
+
+	opened, err := iCipher.Decrypt(tenantId, field, sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", opened)
+
+	_, err = iCipher.Decrypt(tenantId, field, "secret")
+	assert.Error(t, err)
+
+	_, err = iCipher.Decrypt(uuid.New(), field, sealed)
+	assert.Error(t, err, "a value of another tenant does not open")
+
+	_, err = iCipher.Decrypt(tenantId, "settings.redis.password", sealed)
+	assert.Error(t, err, "a value of another field does not open")
+
+	resealed, err := iCipher.Encrypt(tenantId, field, sealed)
+	assert.NoError(t, err)
+	assert.NotEqual(t, sealed, resealed, "ciphertext handed in is sealed like any plaintext")
+
+	opened, err = iCipher.Decrypt(tenantId, field, resealed)
+	assert.NoError(t, err)
+	assert.Equal(t, sealed, opened)
+
+	_, err = iCipher.Decrypt(tenantId, field, sealed[:len(sealed)-4]+"AAAA")
+	assert.Error(t, err)
+}
+
+func TestCipher_Rotate(t *testing.T) {
+	tenantId := uuid.New()
+	oldCipher := newCipher(t, key, nil)
+	rotatedCipher := newCipher(t, rotatedKey, []string{key})
+
+	sealed, err := oldCipher.Encrypt(tenantId, field, "secret")
+	assert.NoError(t, err)
+
+	opened, err := rotatedCipher.Decrypt(tenantId, field, sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", opened)
+
+	rotated, err := rotatedCipher.Rotate(tenantId, field, sealed)
+	assert.NoError(t, err)
+	assert.NotEqual(t, sealed, rotated)
+
+	again, err := rotatedCipher.Rotate(tenantId, field, rotated)
+	assert.NoError(t, err)
+	assert.Equal(t, rotated, again)
+
+	opened, err = newCipher(t, rotatedKey, nil).Decrypt(tenantId, field, rotated)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", opened)
+
+	_, err = newCipher(t, rotatedKey, nil).Decrypt(tenantId, field, sealed)
+	assert.Error(t, err)
+
+	_, err = rotatedCipher.Rotate(uuid.New(), field, sealed)
+	assert.Error(t, err)
+
+	plaintext, err := rotatedCipher.Rotate(tenantId, field, "secret")
+	assert.NoError(t, err)
+	opened, err = rotatedCipher.Decrypt(tenantId, field, plaintext)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", opened)
+
+	legacy := "enc:" + strings.SplitN(strings.TrimPrefix(sealed, "enc:"), ":", 2)[1]
+	opened, err = rotatedCipher.Decrypt(tenantId, field, legacy)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", opened)
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 	"health-check/infrastructure/notification"
 	"health-check/infrastructure/postgres"
@@ -18,6 +19,13 @@ type SConfig struct {
 	Notification *notification.SConfig `validate:"required"`
 	Logger       *SLogger              `validate:"required"`
 	Tracer       *STracer              `validate:"required"`
+	Escalation   *SEscalation          `validate:"required"`
+	Flapping     *SFlapping            `validate:"required"`
+	Idempotency  *SIdempotency         `validate:"required"`
+	GitOps       *SGitOps              `validate:"required"`
+	Readiness    *SReadiness           `validate:"required"`
+	Encryption   *SEncryption          `validate:"required"`
+	Template     *STemplate            `validate:"required"`
 }
 
 func NewConfig() *SConfig {
@@ -37,10 +45,22 @@ func NewConfig() *SConfig {
 		log.Fatalln("error in unmarshal config ", err)
 	}
 
+	if config.Encryption != nil && config.Encryption.Key == "" {
+		log.Fatalln("encryption key is not set, set ENCRYPTION_KEY to the base64 of 32 random bytes")
+	}
+
 	if err := validator.New().Struct(config); err != nil {
 		log.Fatalln("error in validate config ", err)
 	}
 
+	if *config.GitOps.IsEnabled && config.GitOps.Tenant() == uuid.Nil {
+		log.Fatalln("gitOps tenant id is not set, set GITOPS_TENANTID to the id of the tenant owning the managed health checks")
+	}
+
+	if !config.GitOps.PruneMode.IsValid() {
+		log.Fatalln(config.GitOps.PruneMode.String(), "gitOps prune mode is not valid !", "valid prune modes is", config.GitOps.PruneMode.List())
+	}
+
 	if !config.Service.Mode.IsValid() {
 		log.Fatalln(config.Service.Mode.String(), "service mode is not valid !", "valid service modes is", config.Service.Mode.List())
 	}
@@ -0,0 +1,6 @@
+package config
+
+type SEncryption struct {
+	Key          string   `validate:"required,base64"`
+	PreviousKeys []string `validate:"dive,base64"`
+}
@@ -0,0 +1,6 @@
+package config
+
+type SEscalation struct {
+	PollIntervalSecond uint `validate:"required"`
+	BatchSize          uint `validate:"required"`
+}
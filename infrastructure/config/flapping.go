@@ -0,0 +1,7 @@
+package config
+
+type SFlapping struct {
+	WindowSize    uint    `validate:"required,min=3"`
+	LowThreshold  float64 `validate:"required"`
+	HighThreshold float64 `validate:"required,gtfield=LowThreshold"`
+}
@@ -0,0 +1,21 @@
+package config
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+)
+
+type SGitOps struct {
+	IsEnabled          *bool           `validate:"required"`
+	Directory          string          `validate:"required"`
+	TenantId           string          `validate:"omitempty,uuid"`
+	PruneMode          enums.PruneMode `validate:"required"`
+	AllowEmptyPrune    *bool           `validate:"required"`
+	PollIntervalSecond uint            `validate:"required"`
+}
+
+// Tenant returns the tenant owning the managed health checks, uuid.Nil when it is not set.
+func (r *SGitOps) Tenant() uuid.UUID {
+	tenantId, _ := uuid.Parse(r.TenantId)
+	return tenantId
+}
@@ -0,0 +1,5 @@
+package config
+
+type SIdempotency struct {
+	TtlMinute uint `validate:"required"`
+}
@@ -0,0 +1,6 @@
+package config
+
+type SReadiness struct {
+	TimeoutSecond         uint `validate:"required"`
+	RefreshIntervalSecond uint `validate:"required"`
+}
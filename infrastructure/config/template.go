@@ -0,0 +1,5 @@
+package config
+
+type STemplate struct {
+	EnvPrefix string `validate:"required"`
+}
@@ -1,6 +1,7 @@
 package cron
 
 import (
+	"errors"
 	"fmt"
 	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-logger"
@@ -20,15 +21,20 @@ type sCron struct {
 
 	cron    *cron.Cron
 	entries map[uint]jobDetails
+	funcs   map[string]cron.EntryID
+	stopped bool
 	mutex   sync.Mutex
 }
 
 func NewCron(logger logger.ILogger) interfaces.ICron {
-	return &sCron{
+	s := &sCron{
 		iLogger: logger,
 		cron:    cron.New(),
 		entries: make(map[uint]jobDetails),
+		funcs:   make(map[string]cron.EntryID),
 	}
+	s.cron.Start()
+	return s
 }
 
 func (r *sCron) AddJob(key uint, createAt time.Time, interval string, job func()) error {
@@ -43,14 +49,7 @@ func (r *sCron) AddJob(key uint, createAt time.Time, interval string, job func()
 		r.remove(key)
 	}
 
-	entryID, err := r.cron.AddFunc(fmt.Sprintf("@every %s", interval), func() {
-		defer func() {
-			if p := recover(); p != nil {
-				r.iLogger.WithAny("panic", p).Error(contextplus.Background(), "recovered from panic")
-			}
-		}()
-		job()
-	})
+	entryID, err := r.cron.AddFunc(fmt.Sprintf("@every %s", interval), r.recover(job))
 	if err != nil {
 		return err
 	}
@@ -60,8 +59,6 @@ func (r *sCron) AddJob(key uint, createAt time.Time, interval string, job func()
 		createdAt: createAt,
 	}
 
-	r.cron.Start()
-
 	return nil
 }
 
@@ -78,3 +75,68 @@ func (r *sCron) remove(key uint) {
 		delete(r.entries, key)
 	}
 }
+
+func (r *sCron) AddFunc(key string, spec string, job func()) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.removeFunc(key)
+
+	entryID, err := r.cron.AddFunc(spec, r.recover(job))
+	if err != nil {
+		return err
+	}
+
+	r.funcs[key] = entryID
+
+	return nil
+}
+
+func (r *sCron) RemoveFunc(key string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.removeFunc(key)
+}
+
+func (r *sCron) removeFunc(key string) {
+	entryID, ok := r.funcs[key]
+	if ok {
+		r.cron.Remove(entryID)
+		delete(r.funcs, key)
+	}
+}
+
+func (r *sCron) Validate(spec string) error {
+	_, err := cron.ParseStandard(spec)
+	return err
+}
+
+func (r *sCron) Ping() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.stopped {
+		return errors.New("cron scheduler is stopped")
+	}
+	return nil
+}
+
+// Stop waits for the running jobs to finish, the scheduler can not be started again.
+func (r *sCron) Stop() {
+	r.mutex.Lock()
+	r.stopped = true
+	r.mutex.Unlock()
+
+	<-r.cron.Stop().Done()
+}
+
+func (r *sCron) recover(job func()) func() {
+	return func() {
+		defer func() {
+			if p := recover(); p != nil {
+				r.iLogger.WithAny("panic", p).Error(contextplus.Background(), "recovered from panic")
+			}
+		}()
+		job()
+	}
+}
@@ -0,0 +1,116 @@
+package dnsProbe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTimeout = 5 * time.Second
+
+	statusNoError  = "NOERROR"
+	statusNxDomain = "NXDOMAIN"
+	statusTimeout  = "TIMEOUT"
+	statusServFail = "SERVFAIL"
+)
+
+type sDnsProbe struct {
+	iLogger logger.ILogger
+}
+
+func NewDnsProbe(logger logger.ILogger) interfaces.IDnsProbe {
+	return &sDnsProbe{
+		iLogger: logger,
+	}
+}
+
+// Lookup resolves the records of the host through the resolver of the settings, or the system one when it is empty,
+// and returns them with the outcome of the query.
+func (r *sDnsProbe) Lookup(ctx *contextplus.Context, host string, settings valueObjects.DnsProbeSettings) (string, []string, error) {
+	timeout := defaultTimeout
+	if settings.TimeoutSecond != 0 {
+		timeout = time.Duration(settings.TimeoutSecond) * time.Second
+	}
+	lookupCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	records, err := r.lookup(lookupCtx, resolver(settings.Resolver), host, settings.RecordType)
+	if err != nil {
+		var dnsError *net.DNSError
+		switch {
+		case errors.As(err, &dnsError) && dnsError.IsNotFound:
+			return statusNxDomain, nil, err
+		case errors.As(err, &dnsError) && dnsError.IsTimeout, errors.Is(err, context.DeadlineExceeded):
+			return statusTimeout, nil, err
+		default:
+			return statusServFail, nil, err
+		}
+	}
+
+	return statusNoError, records, nil
+}
+
+func (r *sDnsProbe) lookup(ctx context.Context, resolver *net.Resolver, host string, recordType enums.DnsRecordType) ([]string, error) {
+	switch recordType {
+	case enums.DnsRecordTypeA, enums.DnsRecordTypeAAAA:
+		network := "ip4"
+		if recordType == enums.DnsRecordTypeAAAA {
+			network = "ip6"
+		}
+		ips, err := resolver.LookupIP(ctx, network, host)
+		if err != nil {
+			return nil, err
+		}
+		records := make([]string, 0, len(ips))
+		for _, ip := range ips {
+			records = append(records, ip.String())
+		}
+		return records, nil
+	case enums.DnsRecordTypeCNAME:
+		cname, err := resolver.LookupCNAME(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		return []string{cname}, nil
+	case enums.DnsRecordTypeMX:
+		mxs, err := resolver.LookupMX(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		records := make([]string, 0, len(mxs))
+		for _, mx := range mxs {
+			records = append(records, fmt.Sprintf("%d %s", mx.Pref, mx.Host))
+		}
+		return records, nil
+	case enums.DnsRecordTypeTXT:
+		return resolver.LookupTXT(ctx, host)
+	default:
+		return nil, fmt.Errorf("unsupported dns record type %q", recordType)
+	}
+}
+
+// resolver sends every query to the given address, a missing port defaults to 53.
+func resolver(address string) *net.Resolver {
+	if address == "" {
+		return net.DefaultResolver
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(strings.Trim(address, "[]"), "53")
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network string, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
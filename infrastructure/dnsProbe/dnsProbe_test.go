@@ -0,0 +1,116 @@
+package dnsProbe
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/net/dns/dnsmessage"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"net"
+	"testing"
+)
+
+// serve answers the queries sent to a local udp port from a fixed zone, names missing from it get NXDOMAIN.
+func serve(t *testing.T, zone map[dnsmessage.Type]map[string][]dnsmessage.ResourceBody) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	go func() {
+		buffer := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buffer)
+			if err != nil {
+				return
+			}
+
+			var request dnsmessage.Message
+			if err = request.Unpack(buffer[:n]); err != nil || len(request.Questions) == 0 {
+				continue
+			}
+			question := request.Questions[0]
+
+			response := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: request.ID, Response: true, Authoritative: true, RCode: dnsmessage.RCodeSuccess},
+				Questions: request.Questions,
+			}
+			bodies, found := zone[question.Type][question.Name.String()]
+			if !found {
+				response.RCode = dnsmessage.RCodeNameError
+			}
+			for _, body := range bodies {
+				response.Answers = append(response.Answers, dnsmessage.Resource{
+					Header: dnsmessage.ResourceHeader{Name: question.Name, Type: question.Type, Class: dnsmessage.ClassINET, TTL: 60},
+					Body:   body,
+				})
+			}
+
+			packed, err := response.Pack()
+			if err != nil {
+				continue
+			}
+			_, _ = conn.WriteTo(packed, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func TestLookup(t *testing.T) {
+	resolver := serve(t, map[dnsmessage.Type]map[string][]dnsmessage.ResourceBody{
+		dnsmessage.TypeA: {
+			"app.example.test.": {&dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}}, &dnsmessage.AResource{A: [4]byte{10, 0, 0, 2}}},
+		},
+		dnsmessage.TypeAAAA: {
+			"app.example.test.": {&dnsmessage.AAAAResource{AAAA: [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}}},
+		},
+		dnsmessage.TypeCNAME: {
+			"www.example.test.": {&dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("app.example.test.")}},
+		},
+		dnsmessage.TypeMX: {
+			"example.test.": {&dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName("mail.example.test.")}},
+		},
+		dnsmessage.TypeTXT: {
+			"example.test.": {&dnsmessage.TXTResource{TXT: []string{"v=spf1 include:_spf.example.test ~all"}}},
+		},
+	})
+
+	tableTests := []struct {
+		name       string
+		host       string
+		recordType enums.DnsRecordType
+		status     string
+		records    []string
+	}{
+		{name: "a", host: "app.example.test.", recordType: enums.DnsRecordTypeA, status: "NOERROR", records: []string{"10.0.0.1", "10.0.0.2"}},
+		{name: "aaaa", host: "app.example.test.", recordType: enums.DnsRecordTypeAAAA, status: "NOERROR", records: []string{"2001:db8::1"}},
+		{name: "cname", host: "www.example.test.", recordType: enums.DnsRecordTypeCNAME, status: "NOERROR", records: []string{"app.example.test."}},
+		{name: "mx", host: "example.test.", recordType: enums.DnsRecordTypeMX, status: "NOERROR", records: []string{"10 mail.example.test."}},
+		{name: "txt", host: "example.test.", recordType: enums.DnsRecordTypeTXT, status: "NOERROR", records: []string{"v=spf1 include:_spf.example.test ~all"}},
+		{name: "missing name", host: "missing.example.test.", recordType: enums.DnsRecordTypeA, status: "NXDOMAIN"},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			iLogger := logger.NewMockILogger(gomock.NewController(t))
+
+			status, records, err := NewDnsProbe(iLogger).Lookup(contextplus.Background(), tableTest.host, valueObjects.DnsProbeSettings{
+				RecordType:    tableTest.recordType,
+				Resolver:      resolver,
+				TimeoutSecond: 2,
+			})
+
+			assert.Equal(t, tableTest.status, status)
+			assert.ElementsMatch(t, tableTest.records, records)
+			if tableTest.records == nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
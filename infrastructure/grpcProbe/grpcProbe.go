@@ -0,0 +1,71 @@
+package grpcProbe
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"health-check/application/interfaces"
+	"health-check/domain/valueObjects"
+	"time"
+)
+
+const defaultTimeout = 10 * time.Second
+
+type sGrpcProbe struct {
+	iLogger logger.ILogger
+}
+
+func NewGrpcProbe(logger logger.ILogger) interfaces.IGrpcProbe {
+	return &sGrpcProbe{
+		iLogger: logger,
+	}
+}
+
+// Check calls grpc.health.v1.Health/Check on the target and returns the serving status, when the call fails the
+// returned status is the grpc code of the failure.
+func (r *sGrpcProbe) Check(ctx *contextplus.Context, target string, settings valueObjects.GrpcProbeSettings) (string, map[string][]string, error) {
+	timeout := defaultTimeout
+	if settings.TimeoutSecond != 0 {
+		timeout = time.Duration(settings.TimeoutSecond) * time.Second
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if settings.Tls {
+		transportCredentials = credentials.NewTLS(&tls.Config{
+			ServerName:         settings.ServerName,
+			InsecureSkipVerify: settings.InsecureSkipVerify,
+		})
+	}
+
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		r.iLogger.WithError(err).WithString("target", target).Error(ctx, "error in dial grpc target")
+		return status.Code(err).String(), nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			r.iLogger.WithError(err).WithString("target", target).Error(ctx, "error in close grpc connection")
+		}
+	}()
+
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if len(settings.Metadata) != 0 {
+		callCtx = metadata.NewOutgoingContext(callCtx, metadata.New(settings.Metadata))
+	}
+
+	var header metadata.MD
+	response, err := grpc_health_v1.NewHealthClient(conn).Check(callCtx, &grpc_health_v1.HealthCheckRequest{Service: settings.Service}, grpc.Header(&header))
+	if err != nil {
+		return status.Code(err).String(), header, err
+	}
+
+	return response.GetStatus().String(), header, nil
+}
@@ -0,0 +1,94 @@
+package grpcProbe
+
+import (
+	"context"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
+	"health-check/domain/valueObjects"
+	"net"
+	"testing"
+)
+
+type sHealthServer struct {
+	*health.Server
+	metadata chan metadata.MD
+}
+
+func (r sHealthServer) Check(ctx context.Context, request *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	r.metadata <- md
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-served-by", "probe-test"))
+	return r.Server.Check(ctx, request)
+}
+
+func setup(t *testing.T) (string, chan metadata.MD) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	healthServer := sHealthServer{Server: health.NewServer(), metadata: make(chan metadata.MD, 1)}
+	healthServer.SetServingStatus("payment", grpc_health_v1.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("billing", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String(), healthServer.metadata
+}
+
+func TestCheck(t *testing.T) {
+	tableTests := []struct {
+		name    string
+		service string
+		status  string
+		code    codes.Code
+	}{
+		{
+			name:    "serving service",
+			service: "payment",
+			status:  "SERVING",
+			code:    codes.OK,
+		},
+		{
+			name:    "not serving service",
+			service: "billing",
+			status:  "NOT_SERVING",
+			code:    codes.OK,
+		},
+		{
+			name:    "unknown service",
+			service: "shipping",
+			status:  "NotFound",
+			code:    codes.NotFound,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			target, received := setup(t)
+			iLogger := logger.NewMockILogger(gomock.NewController(t))
+
+			status, header, err := NewGrpcProbe(iLogger).Check(contextplus.Background(), target, valueObjects.GrpcProbeSettings{
+				Service:       tableTest.service,
+				Metadata:      map[string]string{"authorization": "Bearer token"},
+				TimeoutSecond: 2,
+			})
+
+			assert.Equal(t, tableTest.status, status)
+			assert.Equal(t, tableTest.code, grpcStatus.Code(err))
+			assert.Equal(t, []string{"probe-test"}, header["x-served-by"])
+			assert.Equal(t, []string{"Bearer token"}, (<-received)["authorization"])
+		})
+	}
+}
@@ -1,16 +1,39 @@
 package notification
 
+import "health-check/domain/enums"
+
 type (
 	SConfig struct {
-		Discord *sDiscord
-		Slack   *sSlack
+		Discord  *sDiscord
+		Slack    *sSlack
+		Delivery *sDelivery `validate:"required"`
 	}
 	sDiscord struct {
-		BotToken   string   `validate:"required"`
-		ChannelIds []string `validate:"required"`
+		BotToken           string   `validate:"required"`
+		ChannelIds         []string `validate:"required"`
+		RateLimitPerMinute uint     `validate:"required"`
 	}
 	sSlack struct {
-		APIToken   string   `validate:"required"`
-		ChannelIds []string `validate:"required"`
+		APIToken           string   `validate:"required"`
+		ChannelIds         []string `validate:"required"`
+		RateLimitPerMinute uint     `validate:"required"`
+	}
+	sDelivery struct {
+		PollIntervalSecond uint `validate:"required"`
+		BatchSize          uint `validate:"required"`
+		MaxAttempts        uint `validate:"required"`
+		BaseBackoffSecond  uint `validate:"required"`
+		MaxBackoffSecond   uint `validate:"required"`
 	}
 )
+
+func (r SConfig) RateLimitPerMinute() map[enums.NotificationProvider]uint {
+	rateLimits := make(map[enums.NotificationProvider]uint)
+	if r.Discord != nil {
+		rateLimits[enums.NotificationProviderDiscord] = r.Discord.RateLimitPerMinute
+	}
+	if r.Slack != nil {
+		rateLimits[enums.NotificationProviderSlack] = r.Slack.RateLimitPerMinute
+	}
+	return rateLimits
+}
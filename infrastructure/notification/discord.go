@@ -2,7 +2,9 @@ package notification
 
 import (
 	"github.com/ehsandavari/go-context-plus"
+	"github.com/nikoksr/notify"
 	"github.com/nikoksr/notify/service/discord"
+	"health-check/domain/enums"
 )
 
 func (r sNotification) AddDiscord() {
@@ -13,6 +15,10 @@ func (r sNotification) AddDiscord() {
 	if err := d.AuthenticateWithBotToken(r.config.Discord.BotToken); err != nil {
 		r.logger.WithError(err).Fatal(contextplus.Background(), "error in Authenticate discord")
 	}
-	d.AddReceivers(r.config.Discord.ChannelIds...)
-	r.notify.UseServices(d)
+	r.defaults[enums.NotificationProviderDiscord] = r.config.Discord.ChannelIds
+	r.services[enums.NotificationProviderDiscord] = func(receivers ...string) notify.Notifier {
+		service := *d
+		service.AddReceivers(receivers...)
+		return service
+	}
 }
@@ -1,40 +1,72 @@
 package notification
 
 import (
+	"errors"
 	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-logger"
 	"github.com/nikoksr/notify"
 	"health-check/application/interfaces"
+	"health-check/domain/enums"
 	"health-check/pkg/tracer"
 )
 
+var ErrorProviderNotConfigured = errors.New("notification provider is not configured")
+
+type tService func(receivers ...string) notify.Notifier
+
 type sNotification struct {
-	logger logger.ILogger
-	tracer tracer.ITracer
-	config *SConfig
-	notify *notify.Notify
+	logger   logger.ILogger
+	tracer   tracer.ITracer
+	config   *SConfig
+	services map[enums.NotificationProvider]tService
+	defaults map[enums.NotificationProvider][]string
 }
 
 func NewNotification(config *SConfig, logger logger.ILogger, tracer tracer.ITracer) interfaces.INotification {
 	n := sNotification{
-		logger: logger,
-		tracer: tracer,
-		config: config,
-		notify: notify.New(),
+		logger:   logger,
+		tracer:   tracer,
+		config:   config,
+		services: make(map[enums.NotificationProvider]tService),
+		defaults: make(map[enums.NotificationProvider][]string),
 	}
 	n.AddDiscord()
 	n.AddSlack()
 	return n
 }
 
-func (r sNotification) Send(ctx *contextplus.Context, subject string, message string) error {
+func (r sNotification) Providers() []enums.NotificationProvider {
+	providers := make([]enums.NotificationProvider, 0, len(r.services))
+	for _, provider := range []enums.NotificationProvider{enums.NotificationProviderDiscord, enums.NotificationProviderSlack} {
+		if _, ok := r.services[provider]; ok {
+			providers = append(providers, provider)
+		}
+	}
+	return providers
+}
+
+func (r sNotification) Send(ctx *contextplus.Context, provider enums.NotificationProvider, receiver string, subject string, message string) error {
 	span, ctx := r.tracer.SpanFromContext(ctx)
 	defer span.Finish()
 
-	if err := r.notify.Send(ctx.Context, subject, message); err != nil {
+	service, ok := r.services[provider]
+	if !ok {
+		span.SetTag("error", true)
+		span.LogKV("err", ErrorProviderNotConfigured)
+		r.logger.WithError(ErrorProviderNotConfigured).WithString("provider", provider.String()).Error(ctx, "error in send notification")
+
+		return ErrorProviderNotConfigured
+	}
+
+	receivers := r.defaults[provider]
+	if len(receiver) != 0 {
+		receivers = []string{receiver}
+	}
+
+	if err := service(receivers...).Send(ctx.Context, subject, message); err != nil {
 		span.SetTag("error", true)
 		span.LogKV("err", err)
-		r.logger.WithError(err).WithString("subject", subject).WithString("message", message).Error(ctx, "error in send notification")
+		r.logger.WithError(err).WithString("provider", provider.String()).WithString("subject", subject).WithString("message", message).Error(ctx, "error in send notification")
 
 		return err
 	}
@@ -1,12 +1,19 @@
 package notification
 
-import "github.com/nikoksr/notify/service/slack"
+import (
+	"github.com/nikoksr/notify"
+	"github.com/nikoksr/notify/service/slack"
+	"health-check/domain/enums"
+)
 
 func (r sNotification) AddSlack() {
 	if r.config.Slack == nil {
 		return
 	}
-	s := slack.New(r.config.Slack.APIToken)
-	s.AddReceivers(r.config.Slack.ChannelIds...)
-	r.notify.UseServices(s)
+	r.defaults[enums.NotificationProviderSlack] = r.config.Slack.ChannelIds
+	r.services[enums.NotificationProviderSlack] = func(receivers ...string) notify.Notifier {
+		s := slack.New(r.config.Slack.APIToken)
+		s.AddReceivers(receivers...)
+		return s
+	}
 }
@@ -36,9 +36,30 @@ func (r *SPostgres) setup() error {
 	return r.Database.AutoMigrate(
 		new(entities.HealthCheck),
 		new(entities.HealthCheckRequest),
+		new(entities.NotificationDelivery),
+		new(entities.NotificationChannel),
+		new(entities.EscalationPolicy),
+		new(entities.Incident),
+		new(entities.Tag),
+		new(entities.Silence),
+		new(entities.Digest),
+		new(entities.HealthCheckDependency),
+		new(entities.HealthCheckLabel),
+		new(entities.RoleBinding),
+		new(entities.ApiKey),
+		new(entities.AuditLog),
+		new(entities.Secret),
 	)
 }
 
+func (r *SPostgres) Ping(ctx *contextplus.Context) error {
+	db, err := r.Database.DB()
+	if err != nil {
+		return err
+	}
+	return db.PingContext(ctx)
+}
+
 func (r *SPostgres) Close() error {
 	db, err := r.Database.DB()
 	if err != nil {
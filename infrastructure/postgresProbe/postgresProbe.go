@@ -0,0 +1,68 @@
+package postgresProbe
+
+import (
+	"context"
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/jackc/pgx/v5"
+	"health-check/application/interfaces"
+	"health-check/domain/valueObjects"
+	"time"
+)
+
+const defaultTimeout = 10 * time.Second
+
+type sPostgresProbe struct {
+	iLogger logger.ILogger
+}
+
+func NewPostgresProbe(logger logger.ILogger) interfaces.IPostgresProbe {
+	return &sPostgresProbe{
+		iLogger: logger,
+	}
+}
+
+// Query connects to the target dsn with the credentials of the settings and runs the query, returning the first
+// column of the first row along with the connect and query latency.
+func (r *sPostgresProbe) Query(ctx *contextplus.Context, target string, settings valueObjects.PostgresProbeSettings) (string, time.Duration, time.Duration, error) {
+	timeout := defaultTimeout
+	if settings.TimeoutSecond != 0 {
+		timeout = time.Duration(settings.TimeoutSecond) * time.Second
+	}
+	queryCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	config, err := pgx.ParseConfig(target)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	if settings.Username != "" {
+		config.User = settings.Username
+	}
+	if settings.Password != "" {
+		config.Password = settings.Password
+	}
+
+	startedAt := time.Now()
+	conn, err := pgx.ConnectConfig(queryCtx, config)
+	connectDuration := time.Since(startedAt)
+	if err != nil {
+		return "", connectDuration, 0, err
+	}
+	defer func() {
+		if err := conn.Close(context.Background()); err != nil {
+			r.iLogger.WithError(err).Error(ctx, "error in close postgres probe connection")
+		}
+	}()
+
+	var value any
+	startedAt = time.Now()
+	err = conn.QueryRow(queryCtx, settings.Query).Scan(&value)
+	queryDuration := time.Since(startedAt)
+	if err != nil {
+		return "", connectDuration, queryDuration, err
+	}
+
+	return fmt.Sprint(value), connectDuration, queryDuration, nil
+}
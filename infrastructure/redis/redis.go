@@ -30,7 +30,7 @@ func NewRedis(config *SConfig, logger logger.ILogger, tracer tracer.ITracer) int
 	}
 }
 
-func (r *sRedis) Set(ctx *contextplus.Context, key string, value any) error {
+func (r *sRedis) Set(ctx *contextplus.Context, key string, value any, ttl time.Duration) error {
 	span, ctx := r.tracer.SpanFromContext(ctx)
 	defer span.Finish()
 
@@ -43,7 +43,7 @@ func (r *sRedis) Set(ctx *contextplus.Context, key string, value any) error {
 		return err
 	}
 
-	if err := r.client.Set(ctx, fmt.Sprintf("%s:%s", r.serviceName, key), valueByte, time.Minute*5).Err(); err != nil {
+	if err := r.client.Set(ctx, fmt.Sprintf("%s:%s", r.serviceName, key), valueByte, ttl).Err(); err != nil {
 		span.SetTag("error", true)
 		span.LogKV("err", err)
 		r.logger.WithError(err).WithString("key", fmt.Sprintf("%s:%s", r.serviceName, key)).WithAny("value", value).WithByteString("valueByte", valueByte).Error(ctx, "error in redis set")
@@ -54,6 +54,31 @@ func (r *sRedis) Set(ctx *contextplus.Context, key string, value any) error {
 	return nil
 }
 
+func (r *sRedis) SetNX(ctx *contextplus.Context, key string, value any, ttl time.Duration) (bool, error) {
+	span, ctx := r.tracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	valueByte, err := json.Marshal(value)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.logger.WithError(err).WithAny("value", value).Error(ctx, "error in json marshal")
+
+		return false, err
+	}
+
+	isSet, err := r.client.SetNX(ctx, fmt.Sprintf("%s:%s", r.serviceName, key), valueByte, ttl).Result()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.logger.WithError(err).WithString("key", fmt.Sprintf("%s:%s", r.serviceName, key)).WithAny("value", value).WithByteString("valueByte", valueByte).Error(ctx, "error in redis set nx")
+
+		return false, err
+	}
+
+	return isSet, nil
+}
+
 func (r *sRedis) Get(ctx *contextplus.Context, key string) (string, error) {
 	span, ctx := r.tracer.SpanFromContext(ctx)
 	defer span.Finish()
@@ -73,6 +98,21 @@ func (r *sRedis) Get(ctx *contextplus.Context, key string) (string, error) {
 	return val, nil
 }
 
+func (r *sRedis) Del(ctx *contextplus.Context, key string) error {
+	span, ctx := r.tracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := r.client.Del(ctx, fmt.Sprintf("%s:%s", r.serviceName, key)).Err(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.logger.WithError(err).WithString("key", fmt.Sprintf("%s:%s", r.serviceName, key)).Error(ctx, "error in redis del")
+
+		return err
+	}
+
+	return nil
+}
+
 func (r *sRedis) Publish(ctx *contextplus.Context, channelName string, message any) error {
 	span, ctx := r.tracer.SpanFromContext(ctx)
 	defer span.Finish()
@@ -99,6 +139,20 @@ func (r *sRedis) Subscribe(ctx *contextplus.Context, channelName string, channel
 	}
 }
 
+func (r *sRedis) Ping(ctx *contextplus.Context) error {
+	span, ctx := r.tracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := r.client.Ping(ctx).Err(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return err
+	}
+
+	return nil
+}
+
 func (r *sRedis) Close() error {
 	return r.client.Close()
 }
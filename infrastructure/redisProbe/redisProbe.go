@@ -0,0 +1,80 @@
+package redisProbe
+
+import (
+	"context"
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/redis/go-redis/v9"
+	"health-check/application/interfaces"
+	"health-check/domain/valueObjects"
+	"time"
+)
+
+const defaultTimeout = 10 * time.Second
+
+type sRedisProbe struct {
+	iLogger logger.ILogger
+}
+
+func NewRedisProbe(logger logger.ILogger) interfaces.IRedisProbe {
+	return &sRedisProbe{
+		iLogger: logger,
+	}
+}
+
+// Command connects to the target url with the credentials of the settings and runs the command, returning its reply
+// along with the connect and command latency.
+func (r *sRedisProbe) Command(ctx *contextplus.Context, target string, settings valueObjects.RedisProbeSettings) (string, time.Duration, time.Duration, error) {
+	timeout := defaultTimeout
+	if settings.TimeoutSecond != 0 {
+		timeout = time.Duration(settings.TimeoutSecond) * time.Second
+	}
+	commandCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	options, err := redis.ParseURL(target)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	if settings.Username != "" {
+		options.Username = settings.Username
+	}
+	if settings.Password != "" {
+		options.Password = settings.Password
+	}
+	options.DialTimeout = timeout
+	options.MaxRetries = -1
+	options.PoolSize = 1
+	options.DisableIndentity = true
+
+	var connectedAt time.Time
+	options.OnConnect = func(ctx context.Context, cn *redis.Conn) error {
+		connectedAt = time.Now()
+		return nil
+	}
+
+	client := redis.NewClient(options)
+	defer func() {
+		if err := client.Close(); err != nil {
+			r.iLogger.WithError(err).Error(ctx, "error in close redis probe client")
+		}
+	}()
+
+	args := make([]any, 0, len(settings.Command))
+	for _, arg := range settings.Command {
+		args = append(args, arg)
+	}
+
+	startedAt := time.Now()
+	value, err := client.Do(commandCtx, args...).Result()
+	finishedAt := time.Now()
+	if connectedAt.IsZero() {
+		return "", finishedAt.Sub(startedAt), 0, err
+	}
+	if err != nil {
+		return "", connectedAt.Sub(startedAt), finishedAt.Sub(connectedAt), err
+	}
+
+	return fmt.Sprint(value), connectedAt.Sub(startedAt), finishedAt.Sub(connectedAt), nil
+}
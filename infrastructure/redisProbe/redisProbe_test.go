@@ -0,0 +1,137 @@
+package redisProbe
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/domain/valueObjects"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// serve speaks just enough resp2 on a local port to accept AUTH with the password and answer PING and GET from values.
+func serve(t *testing.T, password string, values map[string]string) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				authenticated := password == ""
+				for {
+					args, err := readCommand(reader)
+					if err != nil {
+						return
+					}
+
+					var reply string
+					switch command := strings.ToUpper(args[0]); {
+					case command == "AUTH":
+						authenticated = args[len(args)-1] == password
+						reply = "+OK\r\n"
+						if !authenticated {
+							reply = "-WRONGPASS invalid username-password pair\r\n"
+						}
+					case !authenticated:
+						reply = "-NOAUTH Authentication required.\r\n"
+					case command == "PING":
+						reply = "+PONG\r\n"
+					case command == "GET":
+						value, ok := values[args[1]]
+						reply = "$-1\r\n"
+						if ok {
+							reply = fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+						}
+					default:
+						reply = fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+					}
+					if _, err = conn.Write([]byte(reply)); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	return "redis://" + listener.Addr().String()
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		if _, err = reader.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, strings.TrimSuffix(arg, "\r\n"))
+	}
+	return args, nil
+}
+
+func TestCommand(t *testing.T) {
+	target := serve(t, "secret", map[string]string{"mode": "primary"})
+
+	tableTests := []struct {
+		name     string
+		settings valueObjects.RedisProbeSettings
+		result   string
+		isError  bool
+	}{
+		{
+			name:     "ping",
+			settings: valueObjects.RedisProbeSettings{Password: "secret", Command: []string{"PING"}},
+			result:   "PONG",
+		},
+		{
+			name:     "configured command",
+			settings: valueObjects.RedisProbeSettings{Password: "secret", Command: []string{"GET", "mode"}},
+			result:   "primary",
+		},
+		{
+			name:     "wrong password",
+			settings: valueObjects.RedisProbeSettings{Password: "wrong", Command: []string{"PING"}},
+			isError:  true,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			iLogger := logger.NewMockILogger(gomock.NewController(t))
+
+			result, connectDuration, queryDuration, err := NewRedisProbe(iLogger).Command(contextplus.Background(), target, tableTest.settings)
+
+			assert.Equal(t, tableTest.result, result)
+			assert.Equal(t, tableTest.isError, err != nil)
+			assert.Positive(t, connectDuration)
+			if !tableTest.isError {
+				assert.Positive(t, queryDuration)
+			}
+		})
+	}
+}
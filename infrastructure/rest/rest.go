@@ -1,14 +1,22 @@
 package rest
 
 import (
+	"context"
+	"errors"
 	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-logger"
 	"github.com/go-resty/resty/v2"
 	"health-check/application/interfaces"
 	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
+type sensitiveKey struct{}
+
 type sRest struct {
 	iLogger logger.ILogger
 	client  *resty.Client
@@ -20,29 +28,78 @@ func NewRest(logger logger.ILogger) interfaces.IRest {
 		client: resty.New().
 			SetPreRequestHook(
 				func(c *resty.Client, r *http.Request) error {
-					logger.WithHttpRequest(r).Info(contextplus.FromContext(r.Context()), "request")
+					logger.WithHttpRequest(maskRequest(r)).Info(contextplus.FromContext(r.Context()), "request")
 					return nil
 				},
 			).
 			OnAfterResponse(
 				func(c *resty.Client, r *resty.Response) error {
-					logger.WithHttpResponse(r.RawResponse).Info(contextplus.FromContext(r.Request.Context()), "response")
+					logger.WithHttpResponse(maskResponse(r)).Info(contextplus.FromContext(r.Request.Context()), "response")
 					return nil
 				},
 			),
 	}
 }
 
-func (r *sRest) Execute(ctx *contextplus.Context, method enums.HttpMethod, url string, headers map[string]string, body map[string]any) (int, http.Header, string, error) {
+// Execute sends the request, the sensitive values it was rendered with are masked in the logs and in the error.
+func (r *sRest) Execute(ctx *contextplus.Context, method enums.HttpMethod, url string, headers map[string]string, body map[string]any, sensitive valueObjects.Sensitive) (int, http.Header, string, error) {
+	var requestCtx context.Context = ctx
+	if len(sensitive) != 0 {
+		requestCtx = context.WithValue(ctx, sensitiveKey{}, sensitive)
+	}
+
 	resp, err := r.client.R().
-		SetContext(ctx).
+		SetContext(requestCtx).
 		SetHeaders(headers).
 		SetBody(body).
 		Execute(method.String(), url)
 	if err != nil {
+		if len(sensitive) != 0 {
+			err = errors.New(sensitive.Mask(err.Error()))
+		}
 		r.iLogger.WithError(err).Error(contextplus.Background(), "error in Execute request")
 		return 0, nil, "", err
 	}
 
 	return resp.StatusCode(), resp.Header(), resp.String(), nil
 }
+
+// maskRequest returns a copy of the request to log with the sensitive values in its url, headers and body masked.
+func maskRequest(r *http.Request) *http.Request {
+	sensitive, _ := r.Context().Value(sensitiveKey{}).(valueObjects.Sensitive)
+	if len(sensitive) == 0 {
+		return r
+	}
+
+	masked := r.Clone(r.Context())
+	masked.URL, _ = url.Parse(sensitive.Mask(r.URL.String()))
+	if masked.URL == nil {
+		masked.URL = &url.URL{}
+	}
+	masked.Header = sensitive.MaskHeader(r.Header)
+	masked.Body = nil
+	masked.GetBody = nil
+	if r.GetBody != nil {
+		if body, err := r.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			masked.Body = io.NopCloser(strings.NewReader(sensitive.Mask(string(data))))
+		}
+	}
+	return masked
+}
+
+// maskResponse returns the raw response to log, with the sensitive values the answer echoes masked.
+func maskResponse(r *resty.Response) *http.Response {
+	sensitive, _ := r.Request.Context().Value(sensitiveKey{}).(valueObjects.Sensitive)
+	if len(sensitive) == 0 || r.RawResponse == nil {
+		return r.RawResponse
+	}
+
+	return &http.Response{
+		Status:        r.RawResponse.Status,
+		ContentLength: r.RawResponse.ContentLength,
+		Proto:         r.RawResponse.Proto,
+		Header:        sensitive.MaskHeader(r.RawResponse.Header),
+		Body:          io.NopCloser(strings.NewReader(sensitive.Mask(r.String()))),
+	}
+}
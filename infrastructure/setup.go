@@ -5,26 +5,37 @@ import (
 	"github.com/ehsandavari/go-jwt"
 	"github.com/ehsandavari/go-logger"
 	"health-check/application/interfaces"
+	"health-check/infrastructure/cipher"
 	"health-check/infrastructure/config"
 	"health-check/infrastructure/cron"
+	"health-check/infrastructure/dnsProbe"
+	"health-check/infrastructure/grpcProbe"
 	"health-check/infrastructure/notification"
 	"health-check/infrastructure/postgres"
+	"health-check/infrastructure/postgresProbe"
 	"health-check/infrastructure/redis"
+	"health-check/infrastructure/redisProbe"
 	"health-check/infrastructure/rest"
 	"health-check/pkg/tracer"
+	"log"
 	"time"
 )
 
 type Infrastructure struct {
-	SConfig       *config.SConfig
-	ILogger       logger.ILogger
-	IJwtServer    jwt.IJwtServer
-	ITracer       tracer.ITracer
-	SPostgres     postgres.SPostgres
-	IRedis        interfaces.IRedis
-	ICron         interfaces.ICron
-	IRest         interfaces.IRest
-	INotification interfaces.INotification
+	SConfig        *config.SConfig
+	ILogger        logger.ILogger
+	IJwtServer     jwt.IJwtServer
+	ITracer        tracer.ITracer
+	SPostgres      postgres.SPostgres
+	IRedis         interfaces.IRedis
+	ICron          interfaces.ICron
+	IRest          interfaces.IRest
+	IGrpcProbe     interfaces.IGrpcProbe
+	IDnsProbe      interfaces.IDnsProbe
+	IPostgresProbe interfaces.IPostgresProbe
+	IRedisProbe    interfaces.IRedisProbe
+	ICipher        interfaces.ICipher
+	INotification  interfaces.INotification
 }
 
 func NewInfrastructure() *Infrastructure {
@@ -50,6 +61,10 @@ func NewInfrastructure() *Infrastructure {
 		sConfig.Tracer.Port,
 		_logger,
 	)
+	iCipher, err := cipher.NewCipher(sConfig.Encryption.Key, sConfig.Encryption.PreviousKeys)
+	if err != nil {
+		log.Fatalln("error in create cipher ", err)
+	}
 	return &Infrastructure{
 		SConfig: sConfig,
 		ILogger: _logger,
@@ -61,18 +76,25 @@ func NewInfrastructure() *Infrastructure {
 			jwt.WithNotBefore(time.Now()),
 			jwt.WithIssuedAt(time.Now()),
 		),
-		ITracer:       _tracer,
-		SPostgres:     postgres.NewPostgres(sConfig.Postgres, _logger),
-		IRedis:        redis.NewRedis(sConfig.Redis, _logger, _tracer),
-		ICron:         cron.NewCron(_logger),
-		IRest:         rest.NewRest(_logger),
-		INotification: notification.NewNotification(sConfig.Notification, _logger, _tracer),
+		ITracer:        _tracer,
+		SPostgres:      postgres.NewPostgres(sConfig.Postgres, _logger),
+		IRedis:         redis.NewRedis(sConfig.Redis, _logger, _tracer),
+		ICron:          cron.NewCron(_logger),
+		IRest:          rest.NewRest(_logger),
+		IGrpcProbe:     grpcProbe.NewGrpcProbe(_logger),
+		IDnsProbe:      dnsProbe.NewDnsProbe(_logger),
+		IPostgresProbe: postgresProbe.NewPostgresProbe(_logger),
+		IRedisProbe:    redisProbe.NewRedisProbe(_logger),
+		ICipher:        iCipher,
+		INotification:  notification.NewNotification(sConfig.Notification, _logger, _tracer),
 	}
 }
 
 func (r *Infrastructure) Close() {
 	ctx := contextplus.Background()
 
+	r.ICron.Stop()
+
 	if err := r.SPostgres.Close(); err != nil {
 		r.ILogger.WithError(err).Error(ctx, "error in close postgres")
 	}
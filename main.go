@@ -1,6 +1,8 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-graceful-shutdown"
 	"github.com/joho/godotenv"
@@ -11,9 +13,16 @@ import (
 	"log"
 )
 
+var gitOpsPlan = flag.Bool("gitops-plan", false, "print the changes the gitops sync would make to the database and exit")
+
 func main() {
+	flag.Parse()
 	log.SetFlags(log.LstdFlags | log.Llongfile)
 	loadEnv()
+	if *gitOpsPlan {
+		plan()
+		return
+	}
 	run()
 }
 
@@ -42,3 +51,19 @@ func run() {
 	}
 	graceful.Shutdown(shutdownFunc, cleanupFunc, newInfrastructure.SConfig.Service.GracefulShutdownSecond)
 }
+
+func plan() {
+	ctx := contextplus.Background()
+	newInfrastructure := infrastructure.NewInfrastructure()
+	defer newInfrastructure.Close()
+	newApplication := application.NewApplication(newInfrastructure, persistence.NewPersistence(newInfrastructure))
+
+	changes, err := newApplication.Jobs.GitOps.Plan(ctx)
+	if err != nil {
+		log.Fatalln("error in plan gitops sync", err)
+	}
+
+	for _, change := range changes {
+		fmt.Printf("%-9s %s/%s\n", change.Action, change.Owner, change.Name)
+	}
+}
@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sApiKeyRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.ApiKey]
+}
+
+func NewApiKeyRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.IApiKeyRepository {
+	return sApiKeyRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.ApiKey](logger, tracer, postgres, "id", "name", "prefix", "expires_at", "last_used_at", "created_by", "created_at", "updated_at"),
+	}
+}
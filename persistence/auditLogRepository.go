@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sAuditLogRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.AuditLog]
+}
+
+func NewAuditLogRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.IAuditLogRepository {
+	return sAuditLogRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.AuditLog](logger, tracer, postgres, "id", "actor_id", "request_id", "action", "entity", "entity_id", "created_at"),
+	}
+}
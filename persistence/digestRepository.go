@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sDigestRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.Digest]
+}
+
+func NewDigestRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.IDigestRepository {
+	return sDigestRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.Digest](logger, tracer, postgres, "id", "notification_channel_id", "schedule", "period", "tag", "created_at", "updated_at"),
+	}
+}
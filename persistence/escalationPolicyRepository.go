@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sEscalationPolicyRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.EscalationPolicy]
+}
+
+func NewEscalationPolicyRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.IEscalationPolicyRepository {
+	return sEscalationPolicyRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.EscalationPolicy](logger, tracer, postgres, "id", "name", "created_at", "updated_at"),
+	}
+}
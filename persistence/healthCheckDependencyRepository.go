@@ -0,0 +1,83 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/google/uuid"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sHealthCheckDependencyRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.HealthCheckDependency]
+}
+
+func NewHealthCheckDependencyRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.IHealthCheckDependencyRepository {
+	return sHealthCheckDependencyRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.HealthCheckDependency](logger, tracer, postgres),
+	}
+}
+
+// DownAncestor walks the dependency graph upwards and returns an ancestor with an unresolved incident.
+func (r sHealthCheckDependencyRepository) DownAncestor(ctx *contextplus.Context, healthCheckId uint) (*uint, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	var ancestorIds []uint
+	result := r.sPostgres.Database.WithContext(ctx).Raw(
+		`WITH RECURSIVE ancestors (id) AS (
+			SELECT parent_id FROM health_check_dependencies WHERE health_check_id = ?
+			UNION
+			SELECT health_check_dependencies.parent_id FROM health_check_dependencies
+			INNER JOIN ancestors ON health_check_dependencies.health_check_id = ancestors.id
+		)
+		SELECT ancestors.id FROM ancestors
+		INNER JOIN health_checks ON health_checks.id = ancestors.id AND health_checks.deleted_at IS NULL
+		INNER JOIN incidents ON incidents.health_check_id = ancestors.id
+		WHERE incidents.status <> ? AND incidents.deleted_at IS NULL
+		LIMIT 1`,
+		healthCheckId, enums.IncidentStatusResolved,
+	).Scan(&ancestorIds)
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return nil, result.Error
+	}
+
+	if len(ancestorIds) == 0 {
+		return nil, nil
+	}
+
+	return &ancestorIds[0], nil
+}
+
+// LockTenant holds a transaction level advisory lock on the dependency graph of the tenant, so two dependencies added
+// at the same time can not close a cycle neither of them sees, it only means something inside a unit of work.
+func (r sHealthCheckDependencyRepository) LockTenant(ctx *contextplus.Context, tenantId uuid.UUID) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	result := r.sPostgres.Database.WithContext(ctx).Exec(
+		`SELECT pg_advisory_xact_lock(hashtext('health_check_dependencies'), hashtext(?))`,
+		tenantId.String(),
+	)
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return result.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sHealthCheckLabelRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.HealthCheckLabel]
+}
+
+func NewHealthCheckLabelRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.IHealthCheckLabelRepository {
+	return sHealthCheckLabelRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.HealthCheckLabel](logger, tracer, postgres),
+	}
+}
+
+// HealthCheckIds returns the health checks whose labels satisfy every key/value pair of the selector.
+func (r sHealthCheckLabelRepository) HealthCheckIds(ctx *contextplus.Context, selector map[string]string) ([]uint, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	pairs := make([][]any, 0, len(selector))
+	for key, value := range selector {
+		pairs = append(pairs, []any{key, value})
+	}
+
+	var healthCheckIds []uint
+	if err := r.sPostgres.Database.WithContext(ctx).
+		Model(new(entities.HealthCheckLabel)).
+		Where("(key, value) IN ?", pairs).
+		Group("health_check_id").
+		Having("COUNT(*) = ?", len(pairs)).
+		Pluck("health_check_id", &healthCheckIds).Error; err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, err
+	}
+
+	return healthCheckIds, nil
+}
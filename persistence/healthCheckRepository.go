@@ -1,7 +1,9 @@
 package persistence
 
 import (
+	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-logger"
+	"gorm.io/gorm"
 	"health-check/application/interfaces"
 	"health-check/domain/entities"
 	"health-check/infrastructure/postgres"
@@ -21,6 +23,68 @@ func NewHealthCheckRepository(logger logger.ILogger, tracer tracer.ITracer, post
 		iLogger:            logger,
 		iTracer:            tracer,
 		sPostgres:          postgres,
-		IGenericRepository: genericRepository.NewGenericRepository[entities.HealthCheck](logger, tracer, postgres),
+		IGenericRepository: genericRepository.NewGenericRepository[entities.HealthCheck](logger, tracer, postgres, "id", "name", "description", "owner", "interval", "url", "method", "type", "status", "escalation_policy_id", "flapping", "version", "managed", "created_at", "updated_at"),
 	}
 }
+
+func (r sHealthCheckRepository) ReplaceTags(ctx *contextplus.Context, healthCheck *entities.HealthCheck, tags []entities.Tag) error {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := r.sPostgres.Database.WithContext(ctx).Model(healthCheck).Association("Tags").Replace(tags); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return err
+	}
+
+	return nil
+}
+
+func (r sHealthCheckRepository) IncrementVersion(ctx *contextplus.Context, healthCheck *entities.HealthCheck) (bool, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	result := r.sPostgres.Database.WithContext(ctx).
+		Model(new(entities.HealthCheck)).
+		Where("id = ? AND version = ?", healthCheck.Id, healthCheck.Version).
+		UpdateColumn("version", gorm.Expr("version + 1"))
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return false, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	healthCheck.IncrementVersion()
+
+	return true, nil
+}
+
+func (r sHealthCheckRepository) AllWithRelations(ctx *contextplus.Context, specifications ...genericRepository.Specification) ([]entities.HealthCheck, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	query := r.sPostgres.Database.WithContext(ctx)
+	for _, specification := range specifications {
+		query = query.Where(specification.GetQuery(), specification.GetValues()...)
+	}
+
+	var healthChecks []entities.HealthCheck
+	if err := query.
+		Preload("Tags").
+		Preload("Labels").
+		Order("owner, name, id").
+		Find(&healthChecks).Error; err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, err
+	}
+
+	return healthChecks, nil
+}
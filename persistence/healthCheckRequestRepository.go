@@ -1,12 +1,16 @@
 package persistence
 
 import (
+	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-logger"
 	"health-check/application/interfaces"
 	"health-check/domain/entities"
+	"health-check/domain/valueObjects"
 	"health-check/infrastructure/postgres"
 	"health-check/pkg/genericRepository"
 	"health-check/pkg/tracer"
+	"net/http"
+	"time"
 )
 
 type sHealthCheckRequestRepository struct {
@@ -24,3 +28,45 @@ func NewHealthCheckRequestRepository(logger logger.ILogger, tracer tracer.ITrace
 		IGenericRepository: genericRepository.NewGenericRepository[entities.HealthCheckRequest](logger, tracer, postgres),
 	}
 }
+
+func (r sHealthCheckRequestRepository) Recent(ctx *contextplus.Context, healthCheckId uint, limit uint) ([]entities.HealthCheckRequest, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	var healthCheckRequests []entities.HealthCheckRequest
+	if err := r.sPostgres.Database.WithContext(ctx).
+		Where("health_check_id = ?", healthCheckId).
+		Order("id DESC").
+		Limit(int(limit)).
+		Find(&healthCheckRequests).Error; err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, err
+	}
+
+	return healthCheckRequests, nil
+}
+
+func (r sHealthCheckRequestRepository) Stats(ctx *contextplus.Context, healthCheckIds []uint, from time.Time, to time.Time) ([]valueObjects.HealthCheckStats, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	var healthCheckStats []valueObjects.HealthCheckStats
+	if err := r.sPostgres.Database.WithContext(ctx).
+		Model(new(entities.HealthCheckRequest)).
+		Select(
+			"health_check_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE status_code = ?) AS succeeded, AVG(duration) AS average_duration_millisecond",
+			http.StatusOK,
+		).
+		Where("health_check_id IN ? AND created_at >= ? AND created_at < ?", healthCheckIds, from, to).
+		Group("health_check_id").
+		Scan(&healthCheckStats).Error; err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, err
+	}
+
+	return healthCheckStats, nil
+}
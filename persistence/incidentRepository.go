@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"gorm.io/gorm"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"time"
+)
+
+type sIncidentRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.Incident]
+}
+
+func NewIncidentRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.IIncidentRepository {
+	return sIncidentRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.Incident](logger, tracer, postgres, "id", "health_check_id", "status", "escalation_step", "next_escalation_at", "unreachable_parent_id", "suppressed", "acknowledged_at", "acknowledged_by", "resolved_at", "created_at", "updated_at"),
+	}
+}
+
+// ClaimDueEscalations leases open incidents whose escalation is due by pushing their next escalation past the lease, so
+// concurrent replicas skip them and a crashed replica's incidents become due again once the lease expires.
+func (r sIncidentRepository) ClaimDueEscalations(ctx *contextplus.Context, limit uint, lease time.Duration) ([]entities.Incident, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	now := time.Now()
+
+	var incidents []entities.Incident
+	result := r.sPostgres.Database.WithContext(ctx).Raw(
+		`UPDATE incidents SET next_escalation_at = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM incidents
+			WHERE status = ? AND acknowledged_at IS NULL AND next_escalation_at <= ? AND deleted_at IS NULL
+			ORDER BY next_escalation_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, enums.IncidentStatusOpen, now, limit,
+	).Scan(&incidents)
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return nil, result.Error
+	}
+
+	return incidents, nil
+}
+
+// UpdateEscalation writes only the escalation columns of incident and only while it is still open and unacknowledged,
+// so an acknowledgement or a resolution racing with the escalation is never overwritten.
+func (r sIncidentRepository) UpdateEscalation(ctx *contextplus.Context, incident *entities.Incident) (bool, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	result := r.sPostgres.Database.WithContext(ctx).
+		Model(new(entities.Incident)).
+		Where("id = ? AND status = ? AND acknowledged_at IS NULL", incident.Id, enums.IncidentStatusOpen).
+		Updates(map[string]any{
+			"escalation_step":    incident.EscalationStep,
+			"next_escalation_at": incident.NextEscalationAt,
+			"escalations":        incident.Escalations,
+		})
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return false, result.Error
+	}
+
+	return result.RowsAffected != 0, nil
+}
+
+// UpdateSuppression writes only the columns that hold an unresolved incident back, an incident acknowledged meanwhile
+// keeps its escalation stopped.
+func (r sIncidentRepository) UpdateSuppression(ctx *contextplus.Context, incident *entities.Incident) (bool, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	result := r.sPostgres.Database.WithContext(ctx).
+		Model(new(entities.Incident)).
+		Where("id = ? AND status <> ?", incident.Id, enums.IncidentStatusResolved).
+		Updates(map[string]any{
+			"unreachable_parent_id": incident.UnreachableParentId,
+			"suppressed":            incident.Suppressed,
+			"next_escalation_at":    gorm.Expr("CASE WHEN acknowledged_at IS NULL THEN ?::timestamptz END", incident.NextEscalationAt),
+		})
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return false, result.Error
+	}
+
+	return result.RowsAffected != 0, nil
+}
+
+// Resolve writes only the resolution columns of incident, false means another run resolved it first.
+func (r sIncidentRepository) Resolve(ctx *contextplus.Context, incident *entities.Incident) (bool, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	result := r.sPostgres.Database.WithContext(ctx).
+		Model(new(entities.Incident)).
+		Where("id = ? AND status <> ?", incident.Id, enums.IncidentStatusResolved).
+		Updates(map[string]any{
+			"status":             incident.Status,
+			"resolved_at":        incident.ResolvedAt,
+			"next_escalation_at": nil,
+		})
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return false, result.Error
+	}
+
+	return result.RowsAffected != 0, nil
+}
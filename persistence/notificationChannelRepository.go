@@ -0,0 +1,51 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/google/uuid"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sNotificationChannelRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.NotificationChannel]
+}
+
+func NewNotificationChannelRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.INotificationChannelRepository {
+	return sNotificationChannelRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.NotificationChannel](logger, tracer, postgres, "id", "name", "provider", "receiver", "created_at", "updated_at"),
+	}
+}
+
+// Routed returns the channels of the tenant whose label selector is satisfied by the labels of the health check.
+func (r sNotificationChannelRepository) Routed(ctx *contextplus.Context, tenantId uuid.UUID, healthCheckId uint) ([]entities.NotificationChannel, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	var notificationChannels []entities.NotificationChannel
+	if err := r.sPostgres.Database.WithContext(ctx).
+		Where("tenant_id = ?", tenantId).
+		Where("labels <> '{}' AND labels <@ (?)", r.sPostgres.Database.
+			Model(new(entities.HealthCheckLabel)).
+			Select("COALESCE(jsonb_object_agg(key, value), '{}')").
+			Where("health_check_id = ?", healthCheckId),
+		).
+		Find(&notificationChannels).Error; err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, err
+	}
+
+	return notificationChannels, nil
+}
@@ -0,0 +1,60 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"time"
+)
+
+type sNotificationDeliveryRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.NotificationDelivery]
+}
+
+func NewNotificationDeliveryRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.INotificationDeliveryRepository {
+	return sNotificationDeliveryRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.NotificationDelivery](logger, tracer, postgres, "id", "provider", "receiver", "subject", "status", "attempts", "next_attempt_at", "sent_at", "created_at", "updated_at"),
+	}
+}
+
+// Claim leases due pending deliveries by pushing their next attempt past the lease, so concurrent
+// workers skip them and a crashed worker's deliveries become due again once the lease expires.
+func (r sNotificationDeliveryRepository) Claim(ctx *contextplus.Context, limit uint, lease time.Duration) ([]entities.NotificationDelivery, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	now := time.Now()
+
+	var notificationDeliveries []entities.NotificationDelivery
+	result := r.sPostgres.Database.WithContext(ctx).Raw(
+		`UPDATE notification_deliveries SET next_attempt_at = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM notification_deliveries
+			WHERE status = ? AND next_attempt_at <= ? AND deleted_at IS NULL
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, enums.DeliveryStatusPending, now, limit,
+	).Scan(&notificationDeliveries)
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return nil, result.Error
+	}
+
+	return notificationDeliveries, nil
+}
@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sRoleBindingRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.RoleBinding]
+}
+
+func NewRoleBindingRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.IRoleBindingRepository {
+	return sRoleBindingRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.RoleBinding](logger, tracer, postgres, "id", "user_id", "role", "created_by", "created_at", "updated_at"),
+	}
+}
@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-logger"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sSecretRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.Secret]
+}
+
+func NewSecretRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.ISecretRepository {
+	return sSecretRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.Secret](logger, tracer, postgres, "id", "name", "description", "created_at", "updated_at"),
+	}
+}
@@ -6,17 +6,56 @@ import (
 )
 
 type Persistence struct {
-	IHealthCheckRepository        interfaces.IHealthCheckRepository
-	IHealthCheckRequestRepository interfaces.IHealthCheckRequestRepository
-	IUnitOfWork                   interfaces.IUnitOfWork
+	IHealthCheckRepository           interfaces.IHealthCheckRepository
+	IHealthCheckRequestRepository    interfaces.IHealthCheckRequestRepository
+	INotificationDeliveryRepository  interfaces.INotificationDeliveryRepository
+	INotificationChannelRepository   interfaces.INotificationChannelRepository
+	IEscalationPolicyRepository      interfaces.IEscalationPolicyRepository
+	IIncidentRepository              interfaces.IIncidentRepository
+	ITagRepository                   interfaces.ITagRepository
+	ISilenceRepository               interfaces.ISilenceRepository
+	IDigestRepository                interfaces.IDigestRepository
+	IHealthCheckDependencyRepository interfaces.IHealthCheckDependencyRepository
+	IHealthCheckLabelRepository      interfaces.IHealthCheckLabelRepository
+	IRoleBindingRepository           interfaces.IRoleBindingRepository
+	IApiKeyRepository                interfaces.IApiKeyRepository
+	IAuditLogRepository              interfaces.IAuditLogRepository
+	ISecretRepository                interfaces.ISecretRepository
+	IUnitOfWork                      interfaces.IUnitOfWork
 }
 
 func NewPersistence(infrastructure *infrastructure.Infrastructure) *Persistence {
 	healthCheckRepository := NewHealthCheckRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
 	healthCheckRequestRepository := NewHealthCheckRequestRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	notificationDeliveryRepository := NewNotificationDeliveryRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	notificationChannelRepository := NewNotificationChannelRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	escalationPolicyRepository := NewEscalationPolicyRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	incidentRepository := NewIncidentRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	tagRepository := NewTagRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	silenceRepository := NewSilenceRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	digestRepository := NewDigestRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	healthCheckDependencyRepository := NewHealthCheckDependencyRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	healthCheckLabelRepository := NewHealthCheckLabelRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	roleBindingRepository := NewRoleBindingRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	apiKeyRepository := NewApiKeyRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	auditLogRepository := NewAuditLogRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
+	secretRepository := NewSecretRepository(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres)
 	return &Persistence{
-		IHealthCheckRepository:        healthCheckRepository,
-		IHealthCheckRequestRepository: healthCheckRequestRepository,
-		IUnitOfWork:                   NewUnitOfWork(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres, healthCheckRepository, healthCheckRequestRepository),
+		IHealthCheckRepository:           healthCheckRepository,
+		IHealthCheckRequestRepository:    healthCheckRequestRepository,
+		INotificationDeliveryRepository:  notificationDeliveryRepository,
+		INotificationChannelRepository:   notificationChannelRepository,
+		IEscalationPolicyRepository:      escalationPolicyRepository,
+		IIncidentRepository:              incidentRepository,
+		ITagRepository:                   tagRepository,
+		ISilenceRepository:               silenceRepository,
+		IDigestRepository:                digestRepository,
+		IHealthCheckDependencyRepository: healthCheckDependencyRepository,
+		IHealthCheckLabelRepository:      healthCheckLabelRepository,
+		IRoleBindingRepository:           roleBindingRepository,
+		IApiKeyRepository:                apiKeyRepository,
+		IAuditLogRepository:              auditLogRepository,
+		ISecretRepository:                secretRepository,
+		IUnitOfWork:                      NewUnitOfWork(infrastructure.ILogger, infrastructure.ITracer, infrastructure.SPostgres, healthCheckRepository, healthCheckRequestRepository, notificationDeliveryRepository, notificationChannelRepository, escalationPolicyRepository, incidentRepository, tagRepository, silenceRepository, digestRepository, healthCheckDependencyRepository, healthCheckLabelRepository, roleBindingRepository, apiKeyRepository, auditLogRepository, secretRepository),
 	}
 }
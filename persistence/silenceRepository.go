@@ -0,0 +1,66 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/google/uuid"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+	"time"
+)
+
+type sSilenceRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.Silence]
+}
+
+func NewSilenceRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.ISilenceRepository {
+	return sSilenceRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.Silence](logger, tracer, postgres, "id", "health_check_id", "tag", "reason", "created_by", "starts_at", "ends_at", "created_at", "updated_at"),
+	}
+}
+
+// Active returns the tenant silence that outlasts every other one covering the health check, either directly
+// through one of its tags or through a label selector its labels satisfy.
+func (r sSilenceRepository) Active(ctx *contextplus.Context, tenantId uuid.UUID, healthCheckId uint, now time.Time) (*entities.Silence, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	var silences []entities.Silence
+	result := r.sPostgres.Database.WithContext(ctx).Raw(
+		`SELECT * FROM silences
+		WHERE deleted_at IS NULL AND tenant_id = ? AND starts_at <= ? AND ends_at > ? AND (
+			health_check_id = ? OR tag IN (
+				SELECT tags.name FROM tags
+				INNER JOIN health_check_tags ON health_check_tags.tag_id = tags.id
+				WHERE health_check_tags.health_check_id = ?
+			) OR (labels <> '{}' AND labels <@ (
+				SELECT COALESCE(jsonb_object_agg(key, value), '{}') FROM health_check_labels
+				WHERE health_check_id = ?
+			))
+		)
+		ORDER BY ends_at DESC
+		LIMIT 1`,
+		tenantId, now, now, healthCheckId, healthCheckId, healthCheckId,
+	).Scan(&silences)
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return nil, result.Error
+	}
+
+	if len(silences) == 0 {
+		return nil, nil
+	}
+
+	return &silences[0], nil
+}
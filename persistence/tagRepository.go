@@ -0,0 +1,50 @@
+package persistence
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/google/uuid"
+	"health-check/application/interfaces"
+	"health-check/domain/entities"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/genericRepository"
+	"health-check/pkg/tracer"
+)
+
+type sTagRepository struct {
+	iLogger   logger.ILogger
+	iTracer   tracer.ITracer
+	sPostgres postgres.SPostgres
+	genericRepository.IGenericRepository[entities.Tag]
+}
+
+func NewTagRepository(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.ITagRepository {
+	return sTagRepository{
+		iLogger:            logger,
+		iTracer:            tracer,
+		sPostgres:          postgres,
+		IGenericRepository: genericRepository.NewGenericRepository[entities.Tag](logger, tracer, postgres),
+	}
+}
+
+// HealthCheckIds returns the health checks carrying every one of the given tags of the tenant.
+func (r sTagRepository) HealthCheckIds(ctx *contextplus.Context, tenantId uuid.UUID, names []string) ([]uint, error) {
+	span, ctx := r.iTracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	var healthCheckIds []uint
+	if err := r.sPostgres.Database.WithContext(ctx).
+		Table("health_check_tags").
+		Joins("INNER JOIN tags ON tags.id = health_check_tags.tag_id").
+		Where("tags.tenant_id = ? AND tags.name IN ?", tenantId, names).
+		Group("health_check_tags.health_check_id").
+		Having("COUNT(DISTINCT tags.name) = ?", len(names)).
+		Pluck("health_check_tags.health_check_id", &healthCheckIds).Error; err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return nil, err
+	}
+
+	return healthCheckIds, nil
+}
@@ -10,11 +10,24 @@ import (
 )
 
 type sUnitOfWork struct {
-	logger                        logger.ILogger
-	tracer                        tracer.ITracer
-	postgres                      postgres.SPostgres
-	iHealthCheckRepository        interfaces.IHealthCheckRepository
-	iHealthCheckRequestRepository interfaces.IHealthCheckRequestRepository
+	logger                           logger.ILogger
+	tracer                           tracer.ITracer
+	postgres                         postgres.SPostgres
+	iHealthCheckRepository           interfaces.IHealthCheckRepository
+	iHealthCheckRequestRepository    interfaces.IHealthCheckRequestRepository
+	iNotificationDeliveryRepository  interfaces.INotificationDeliveryRepository
+	iNotificationChannelRepository   interfaces.INotificationChannelRepository
+	iEscalationPolicyRepository      interfaces.IEscalationPolicyRepository
+	iIncidentRepository              interfaces.IIncidentRepository
+	iTagRepository                   interfaces.ITagRepository
+	iSilenceRepository               interfaces.ISilenceRepository
+	iDigestRepository                interfaces.IDigestRepository
+	iHealthCheckDependencyRepository interfaces.IHealthCheckDependencyRepository
+	iHealthCheckLabelRepository      interfaces.IHealthCheckLabelRepository
+	iRoleBindingRepository           interfaces.IRoleBindingRepository
+	iApiKeyRepository                interfaces.IApiKeyRepository
+	iAuditLogRepository              interfaces.IAuditLogRepository
+	iSecretRepository                interfaces.ISecretRepository
 }
 
 func NewUnitOfWork(
@@ -23,13 +36,39 @@ func NewUnitOfWork(
 	postgres postgres.SPostgres,
 	healthCheckRepository interfaces.IHealthCheckRepository,
 	healthCheckRequestRepository interfaces.IHealthCheckRequestRepository,
+	notificationDeliveryRepository interfaces.INotificationDeliveryRepository,
+	notificationChannelRepository interfaces.INotificationChannelRepository,
+	escalationPolicyRepository interfaces.IEscalationPolicyRepository,
+	incidentRepository interfaces.IIncidentRepository,
+	tagRepository interfaces.ITagRepository,
+	silenceRepository interfaces.ISilenceRepository,
+	digestRepository interfaces.IDigestRepository,
+	healthCheckDependencyRepository interfaces.IHealthCheckDependencyRepository,
+	healthCheckLabelRepository interfaces.IHealthCheckLabelRepository,
+	roleBindingRepository interfaces.IRoleBindingRepository,
+	apiKeyRepository interfaces.IApiKeyRepository,
+	auditLogRepository interfaces.IAuditLogRepository,
+	secretRepository interfaces.ISecretRepository,
 ) interfaces.IUnitOfWork {
 	return &sUnitOfWork{
-		logger:                        logger,
-		tracer:                        tracer,
-		postgres:                      postgres,
-		iHealthCheckRepository:        healthCheckRepository,
-		iHealthCheckRequestRepository: healthCheckRequestRepository,
+		logger:                           logger,
+		tracer:                           tracer,
+		postgres:                         postgres,
+		iHealthCheckRepository:           healthCheckRepository,
+		iHealthCheckRequestRepository:    healthCheckRequestRepository,
+		iNotificationDeliveryRepository:  notificationDeliveryRepository,
+		iNotificationChannelRepository:   notificationChannelRepository,
+		iEscalationPolicyRepository:      escalationPolicyRepository,
+		iIncidentRepository:              incidentRepository,
+		iTagRepository:                   tagRepository,
+		iSilenceRepository:               silenceRepository,
+		iDigestRepository:                digestRepository,
+		iHealthCheckDependencyRepository: healthCheckDependencyRepository,
+		iHealthCheckLabelRepository:      healthCheckLabelRepository,
+		iRoleBindingRepository:           roleBindingRepository,
+		iApiKeyRepository:                apiKeyRepository,
+		iAuditLogRepository:              auditLogRepository,
+		iSecretRepository:                secretRepository,
 	}
 }
 
@@ -41,13 +80,64 @@ func (r sUnitOfWork) HealthCheckRequestRepository() interfaces.IHealthCheckReque
 	return r.iHealthCheckRequestRepository
 }
 
+func (r sUnitOfWork) NotificationDeliveryRepository() interfaces.INotificationDeliveryRepository {
+	return r.iNotificationDeliveryRepository
+}
+
+func (r sUnitOfWork) NotificationChannelRepository() interfaces.INotificationChannelRepository {
+	return r.iNotificationChannelRepository
+}
+
+func (r sUnitOfWork) EscalationPolicyRepository() interfaces.IEscalationPolicyRepository {
+	return r.iEscalationPolicyRepository
+}
+
+func (r sUnitOfWork) IncidentRepository() interfaces.IIncidentRepository {
+	return r.iIncidentRepository
+}
+
+func (r sUnitOfWork) TagRepository() interfaces.ITagRepository {
+	return r.iTagRepository
+}
+
+func (r sUnitOfWork) SilenceRepository() interfaces.ISilenceRepository {
+	return r.iSilenceRepository
+}
+
+func (r sUnitOfWork) DigestRepository() interfaces.IDigestRepository {
+	return r.iDigestRepository
+}
+
+func (r sUnitOfWork) HealthCheckDependencyRepository() interfaces.IHealthCheckDependencyRepository {
+	return r.iHealthCheckDependencyRepository
+}
+
+func (r sUnitOfWork) HealthCheckLabelRepository() interfaces.IHealthCheckLabelRepository {
+	return r.iHealthCheckLabelRepository
+}
+
+func (r sUnitOfWork) RoleBindingRepository() interfaces.IRoleBindingRepository {
+	return r.iRoleBindingRepository
+}
+
+func (r sUnitOfWork) ApiKeyRepository() interfaces.IApiKeyRepository {
+	return r.iApiKeyRepository
+}
+
+func (r sUnitOfWork) AuditLogRepository() interfaces.IAuditLogRepository {
+	return r.iAuditLogRepository
+}
+
+func (r sUnitOfWork) SecretRepository() interfaces.ISecretRepository {
+	return r.iSecretRepository
+}
+
 func (r sUnitOfWork) Do(ctx *contextplus.Context, unitOfWorkBlock func(interfaces.IUnitOfWork) error) error {
 	span, ctx := r.tracer.SpanFromContext(ctx)
 	defer span.Finish()
 
 	if err := r.postgres.Database.Transaction(func(tx *gorm.DB) error {
-		r.postgres.Database = tx
-		return unitOfWorkBlock(r)
+		return unitOfWorkBlock(newTransactionUnitOfWork(r.logger, r.tracer, postgres.SPostgres{Database: tx}))
 	}); err != nil {
 		span.SetTag("error", true)
 		span.LogKV("err", err)
@@ -57,3 +147,28 @@ func (r sUnitOfWork) Do(ctx *contextplus.Context, unitOfWorkBlock func(interface
 
 	return nil
 }
+
+// newTransactionUnitOfWork binds every repository to the transaction of Do, so the queries of the block and the locks
+// they take live and die with it.
+func newTransactionUnitOfWork(logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) interfaces.IUnitOfWork {
+	return NewUnitOfWork(
+		logger,
+		tracer,
+		postgres,
+		NewHealthCheckRepository(logger, tracer, postgres),
+		NewHealthCheckRequestRepository(logger, tracer, postgres),
+		NewNotificationDeliveryRepository(logger, tracer, postgres),
+		NewNotificationChannelRepository(logger, tracer, postgres),
+		NewEscalationPolicyRepository(logger, tracer, postgres),
+		NewIncidentRepository(logger, tracer, postgres),
+		NewTagRepository(logger, tracer, postgres),
+		NewSilenceRepository(logger, tracer, postgres),
+		NewDigestRepository(logger, tracer, postgres),
+		NewHealthCheckDependencyRepository(logger, tracer, postgres),
+		NewHealthCheckLabelRepository(logger, tracer, postgres),
+		NewRoleBindingRepository(logger, tracer, postgres),
+		NewApiKeyRepository(logger, tracer, postgres),
+		NewAuditLogRepository(logger, tracer, postgres),
+		NewSecretRepository(logger, tracer, postgres),
+	)
+}
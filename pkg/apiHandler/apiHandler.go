@@ -1,14 +1,17 @@
 package apiHandler
 
 import (
+	"errors"
 	"github.com/ehsandavari/go-context-plus"
 	"github.com/ehsandavari/go-logger"
 	"github.com/gin-contrib/i18n"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	"go/types"
 	"health-check/application/common"
 	"health-check/pkg/tracer"
+	"io"
 	"net/http"
 )
 
@@ -24,6 +27,43 @@ func NewBaseController(iLogger logger.ILogger, iTracer tracer.ITracer) SBaseCont
 	}
 }
 
+type IIfMatchRequest interface {
+	SetIfMatch(ifMatch string)
+}
+
+type IIdempotencyKeyRequest interface {
+	SetIdempotencyKey(idempotencyKey string)
+}
+
+// IRawRequest is filled from the path params and the unparsed body instead of being bound from json.
+type IRawRequest interface {
+	SetRaw(param func(key string) string, body []byte)
+}
+
+type IETagResponse interface {
+	ETag() string
+}
+
+type IRawResponse interface {
+	Raw() (contentType string, data []byte, err error)
+}
+
+const maxRawRequestSize = 1 << 20
+
+var statusCodes = map[uint]int{
+	common.ErrorBadRequest.Code(): http.StatusBadRequest,
+	common.ErrorNotFound.Code():   http.StatusNotFound,
+	common.ErrorForbidden.Code():  http.StatusForbidden,
+	common.ErrorConflict.Code():   http.StatusConflict,
+}
+
+func statusCode(iError common.IError) int {
+	if code, ok := statusCodes[iError.Code()]; ok {
+		return code
+	}
+	return http.StatusInternalServerError
+}
+
 type BaseController[TReq, TRes any] func(ctx *contextplus.Context, request TReq) (TRes, error)
 
 func (r BaseController[TReq, TRes]) Handle(iLogger logger.ILogger) gin.HandlerFunc {
@@ -32,8 +72,26 @@ func (r BaseController[TReq, TRes]) Handle(iLogger logger.ILogger) gin.HandlerFu
 		ctx := contextplus.FromContext(reqCtx)
 
 		var request TReq
-		if _, ok := any(request).(*types.Nil); !ok {
-			if bindErr := ctxGin.ShouldBind(&request); bindErr != nil {
+		if rawRequest, ok := any(&request).(IRawRequest); ok {
+			body, readErr := io.ReadAll(io.LimitReader(ctxGin.Request.Body, maxRawRequestSize))
+			if readErr != nil {
+				iLogger.WithError(readErr).Warn(ctx, "error in read request body")
+				ctxGin.JSON(http.StatusBadRequest, NewBaseApiResponse[ApiError](
+					false,
+					NewApiError(http.StatusBadRequest, "error in validate request"),
+				))
+				return
+			}
+			rawRequest.SetRaw(ctxGin.Param, body)
+		} else if _, ok := any(request).(*types.Nil); !ok {
+			bindErr := bindUri(ctxGin, &request)
+			if bindErr == nil {
+				// an empty body only leaves the path params to bind, so it is validated as it stands
+				if bindErr = ctxGin.ShouldBind(&request); errors.Is(bindErr, io.EOF) {
+					bindErr = binding.Validator.ValidateStruct(&request)
+				}
+			}
+			if bindErr != nil {
 				iLogger.WithError(bindErr).Warn(ctx, "error in Bind request")
 				err := NewApiError(http.StatusBadRequest, "error in validate request")
 				if validationErrors, ok := bindErr.(validator.ValidationErrors); ok {
@@ -51,17 +109,44 @@ func (r BaseController[TReq, TRes]) Handle(iLogger logger.ILogger) gin.HandlerFu
 			}
 		}
 
+		if ifMatchRequest, ok := any(&request).(IIfMatchRequest); ok {
+			ifMatchRequest.SetIfMatch(ctxGin.GetHeader("If-Match"))
+		}
+
+		if idempotencyKeyRequest, ok := any(&request).(IIdempotencyKeyRequest); ok {
+			idempotencyKeyRequest.SetIdempotencyKey(ctxGin.GetHeader("Idempotency-Key"))
+		}
+
 		result, err := r(ctx, request)
 		if err != nil {
 			iError := err.(common.IError)
 			iLogger.WithUint("ErrorCode", iError.Code()).WithError(iError).Debug(ctx, "error in handler")
-			ctxGin.JSON(http.StatusInternalServerError, NewBaseApiResponse[ApiError](
+			ctxGin.JSON(statusCode(iError), NewBaseApiResponse[ApiError](
 				false,
 				NewApiError(iError.Code(), i18n.MustGetMessage(ctxGin, iError.Error())),
 			))
 			return
 		}
 
+		if rawResponse, ok := any(result).(IRawResponse); ok {
+			contentType, data, err := rawResponse.Raw()
+			if err != nil {
+				iLogger.WithError(err).Error(ctx, "error in render raw response")
+				ctxGin.JSON(http.StatusInternalServerError, NewBaseApiResponse[ApiError](
+					false,
+					NewApiError(common.ErrorInternalServer.Code(), i18n.MustGetMessage(ctxGin, common.ErrorInternalServer.Error())),
+				))
+				return
+			}
+
+			ctxGin.Data(http.StatusOK, contentType, data)
+			return
+		}
+
+		if eTagResponse, ok := any(result).(IETagResponse); ok {
+			ctxGin.Header("ETag", eTagResponse.ETag())
+		}
+
 		ctxGin.JSON(http.StatusOK, NewBaseApiResponse[TRes](
 			true,
 			result,
@@ -97,3 +182,13 @@ func NewApiError(code uint, message string) ApiError {
 func (r *ApiError) SetMeta(meta any) {
 	r.Meta = meta
 }
+
+// bindUri fills the fields tagged uri from the path params, tagging them json:"-" as well keeps the body from
+// overriding them.
+func bindUri(ctxGin *gin.Context, request any) error {
+	params := make(map[string][]string, len(ctxGin.Params))
+	for _, param := range ctxGin.Params {
+		params[param.Key] = []string{param.Value}
+	}
+	return binding.MapFormWithTag(request, params, "uri")
+}
@@ -0,0 +1,72 @@
+package apiHandler
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type uriRequest struct {
+	Id   uint   `json:"-" uri:"id" binding:"required"`
+	Note string `binding:"omitempty,max=10"`
+}
+
+func TestBaseControllerBindUri(t *testing.T) {
+	tableTests := []struct {
+		name   string
+		path   string
+		body   string
+		status int
+		id     uint
+	}{
+		{
+			name:   "id from the path without a body",
+			path:   "/7/retry",
+			status: http.StatusOK,
+			id:     7,
+		},
+		{
+			name:   "body does not override the path id",
+			path:   "/7/retry",
+			body:   `{"Id":9,"Note":"again"}`,
+			status: http.StatusOK,
+			id:     7,
+		},
+		{
+			name:   "path id that is not a number",
+			path:   "/seven/retry",
+			status: http.StatusBadRequest,
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			iLogger := logger.NewMockILogger(gomock.NewController(t))
+			iLogger.EXPECT().WithError(gomock.Any()).Return(iLogger).AnyTimes()
+			iLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			var received uriRequest
+			router := gin.New()
+			router.PATCH("/:id/retry", BaseController[uriRequest, string](func(ctx *contextplus.Context, request uriRequest) (string, error) {
+				received = request
+				return "", nil
+			}).Handle(iLogger))
+
+			request := httptest.NewRequest(http.MethodPatch, tableTest.path, strings.NewReader(tableTest.body))
+			request.Header.Set("Content-Type", "application/json")
+			request = request.WithContext(contextplus.Background().ToContext())
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tableTest.status, recorder.Code)
+			assert.Equal(t, tableTest.id, received.Id)
+		})
+	}
+}
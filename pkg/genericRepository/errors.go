@@ -6,4 +6,5 @@ import (
 
 var (
 	ErrorMultipleRowsReturned = errors.New("MultipleRowsReturned")
+	ErrorColumnNotAllowed     = errors.New("ColumnNotAllowed")
 )
@@ -8,10 +8,12 @@ import (
 	"health-check/application/common"
 	"health-check/infrastructure/postgres"
 	"health-check/pkg/tracer"
+	"slices"
+	"strings"
 )
 
 type IGenericRepository[TE any] interface {
-	Paginate(ctx *contextplus.Context, listQuery common.PaginateQuery) (int64, []TE, error)
+	Paginate(ctx *contextplus.Context, listQuery common.PaginateQuery, specifications ...Specification) (int64, []TE, error)
 	Count(ctx *contextplus.Context, specifications ...Specification) (int64, error)
 	Exists(ctx *contextplus.Context, specifications ...Specification) (bool, error)
 	All(ctx *contextplus.Context, specifications ...Specification) ([]TE, error)
@@ -26,21 +28,26 @@ type IGenericRepository[TE any] interface {
 	Create(ctx *contextplus.Context, entity *TE) error
 	Creates(ctx *contextplus.Context, entity ...TE) ([]TE, error)
 	Update(ctx *contextplus.Context, entity *TE, specifications ...Specification) (*TE, error)
+	Save(ctx *contextplus.Context, entity *TE) (*TE, error)
 	UpdateColumn(ctx *contextplus.Context, column string, value any, specifications ...Specification) (*TE, error)
 	Delete(ctx *contextplus.Context, entity *TE, specifications ...Specification) (*TE, error)
 }
 
 type sGenericRepository[TE any] struct {
-	logger   logger.ILogger
-	tracer   tracer.ITracer
-	postgres postgres.SPostgres
+	logger          logger.ILogger
+	tracer          tracer.ITracer
+	postgres        postgres.SPostgres
+	paginateColumns []string
 }
 
-func NewGenericRepository[TE any](logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres) IGenericRepository[TE] {
+// NewGenericRepository builds the repository of TE, paginateColumns are the only columns Paginate lets callers filter
+// and order by since both end up in the SQL text.
+func NewGenericRepository[TE any](logger logger.ILogger, tracer tracer.ITracer, postgres postgres.SPostgres, paginateColumns ...string) IGenericRepository[TE] {
 	return sGenericRepository[TE]{
-		logger:   logger,
-		tracer:   tracer,
-		postgres: postgres,
+		logger:          logger,
+		tracer:          tracer,
+		postgres:        postgres,
+		paginateColumns: paginateColumns,
 	}
 }
 
@@ -52,23 +59,37 @@ func (r sGenericRepository[TE]) Specification(ctx *contextplus.Context, specific
 	return dbPreWarm
 }
 
-func (r sGenericRepository[TE]) Paginate(ctx *contextplus.Context, paginateQuery common.PaginateQuery) (int64, []TE, error) {
+func (r sGenericRepository[TE]) Paginate(ctx *contextplus.Context, paginateQuery common.PaginateQuery, specifications ...Specification) (int64, []TE, error) {
 	span, ctx := r.tracer.SpanFromContext(ctx)
 	defer span.Finish()
 
-	query := r.postgres.Database.WithContext(ctx)
-	if paginateQuery.Filters != nil {
-		for _, filter := range paginateQuery.Filters {
-			query = query.Where(fmt.Sprintf("%s %s", filter.Key, filter.Comparison), filter.Value)
+	orderBy, err := r.orderBy(paginateQuery.GetOrderBy())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+
+		return 0, nil, err
+	}
+	for _, filter := range paginateQuery.Filters {
+		if !slices.Contains(r.paginateColumns, filter.Key) || !filter.Comparison.IsValid() {
+			span.SetTag("error", true)
+			span.LogKV("err", ErrorColumnNotAllowed)
+
+			return 0, nil, ErrorColumnNotAllowed
 		}
 	}
 
+	query := r.Specification(ctx, specifications...)
+	for _, filter := range paginateQuery.Filters {
+		query = query.Where(fmt.Sprintf("%s %s", filter.Key, filter.Comparison), filter.Value)
+	}
+
 	var entityObjects []TE
 
 	var totalRows int64
 	query.Model(entityObjects).Count(&totalRows)
 
-	result := query.Offset(int(paginateQuery.GetOffset())).Limit(int(paginateQuery.GetLimit())).Order(paginateQuery.GetOrderBy()).Find(&entityObjects)
+	result := query.Offset(int(paginateQuery.GetOffset())).Limit(int(paginateQuery.GetLimit())).Order(orderBy).Find(&entityObjects)
 	if result.Error != nil {
 		span.SetTag("error", true)
 		span.LogKV("err", result.Error)
@@ -79,6 +100,30 @@ func (r sGenericRepository[TE]) Paginate(ctx *contextplus.Context, paginateQuery
 	return totalRows, entityObjects, nil
 }
 
+// orderBy rebuilds an "column [asc|desc], ..." clause from the allowed columns only.
+func (r sGenericRepository[TE]) orderBy(orderBy string) (string, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return "", nil
+	}
+
+	var clauses []string
+	for _, clause := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(clause)
+		if len(fields) == 0 || len(fields) > 2 || !slices.Contains(r.paginateColumns, fields[0]) {
+			return "", ErrorColumnNotAllowed
+		}
+		direction := "ASC"
+		if len(fields) == 2 {
+			direction = strings.ToUpper(fields[1])
+			if direction != "ASC" && direction != "DESC" {
+				return "", ErrorColumnNotAllowed
+			}
+		}
+		clauses = append(clauses, fields[0]+" "+direction)
+	}
+	return strings.Join(clauses, ", "), nil
+}
+
 func (r sGenericRepository[TE]) Count(ctx *contextplus.Context, specifications ...Specification) (int64, error) {
 	span, ctx := r.tracer.SpanFromContext(ctx)
 	defer span.Finish()
@@ -338,6 +383,20 @@ func (r sGenericRepository[TE]) Update(ctx *contextplus.Context, entity *TE, spe
 	return entity, nil
 }
 
+func (r sGenericRepository[TE]) Save(ctx *contextplus.Context, entity *TE) (*TE, error) {
+	span, ctx := r.tracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	result := r.postgres.Database.WithContext(ctx).Save(entity)
+	if result.Error != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", result.Error)
+
+		return nil, result.Error
+	}
+	return entity, nil
+}
+
 func (r sGenericRepository[TE]) UpdateColumn(ctx *contextplus.Context, column string, value any, specifications ...Specification) (*TE, error) {
 	span, ctx := r.tracer.SpanFromContext(ctx)
 	defer span.Finish()
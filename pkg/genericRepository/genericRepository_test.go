@@ -0,0 +1,67 @@
+package genericRepository
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"health-check/application/common"
+	"health-check/infrastructure/postgres"
+	"health-check/pkg/tracer"
+	"testing"
+)
+
+func TestPaginateColumns(t *testing.T) {
+	tableTests := []struct {
+		name          string
+		paginateQuery common.PaginateQuery
+	}{
+		{
+			name:          "filter on a column outside the allowlist",
+			paginateQuery: common.PaginateQuery{Filters: []common.FilterQuery{{Key: "1=1 OR name", Comparison: common.ComparisonTypeEquals, Value: "x"}}},
+		},
+		{
+			name:          "filter with an unknown comparison",
+			paginateQuery: common.PaginateQuery{Filters: []common.FilterQuery{{Key: "name", Comparison: "IS NOT NULL OR ?", Value: "x"}}},
+		},
+		{
+			name:          "order by a column outside the allowlist",
+			paginateQuery: common.PaginateQuery{OrderBy: "key_hash"},
+		},
+		{
+			name:          "order by an expression",
+			paginateQuery: common.PaginateQuery{OrderBy: "name; DROP TABLE health_checks"},
+		},
+		{
+			name:          "order by an unknown direction",
+			paginateQuery: common.PaginateQuery{OrderBy: "name sideways"},
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			iTracer := tracer.NewMockITracer(mockController)
+			iSpan := tracer.NewMockISpan(mockController)
+			repository := NewGenericRepository[struct{}](nil, iTracer, postgres.SPostgres{}, "name", "created_at")
+			ctx := contextplus.Background()
+
+			iTracer.EXPECT().SpanFromContext(ctx).Return(iSpan, ctx).Times(1)
+			iSpan.EXPECT().SetTag("error", true).Times(1)
+			iSpan.EXPECT().LogKV("err", ErrorColumnNotAllowed).Times(1)
+			iSpan.EXPECT().Finish().Times(1)
+
+			_, _, err := repository.Paginate(ctx, tableTest.paginateQuery)
+
+			assert.Equal(t, ErrorColumnNotAllowed, err)
+		})
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	repository := sGenericRepository[struct{}]{paginateColumns: []string{"name", "created_at"}}
+
+	orderBy, err := repository.orderBy("name desc, created_at")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "name DESC, created_at ASC", orderBy)
+}
@@ -120,7 +120,7 @@ func LessThan[T comparable](field string, value T) Specification {
 func LessOrEqual[T comparable](field string, value T) Specification {
 	return binaryOperatorSpecification[T]{
 		field:    field,
-		operator: ">=",
+		operator: "<=",
 		value:    value,
 	}
 }
@@ -141,6 +141,14 @@ func NotIn[T any](field string, value ...T) Specification {
 	}
 }
 
+func ILike(field string, value string) Specification {
+	return binaryOperatorSpecification[string]{
+		field:    field,
+		operator: "ILIKE",
+		value:    value,
+	}
+}
+
 type stringSpecification string
 
 func (s stringSpecification) GetQuery() string {
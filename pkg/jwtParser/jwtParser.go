@@ -0,0 +1,49 @@
+package jwtParser
+
+import (
+	"errors"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrEmptySubject = errors.New("token subject is empty")
+
+// SClaims holds the claims of one token, every Parse call fills its own value so concurrent requests never share them.
+type SClaims struct {
+	Email               string `json:"email,omitempty"`
+	EmailVerified       bool   `json:"email_verified,omitempty"`
+	PhoneNumber         string `json:"phone_number,omitempty"`
+	PhoneNumberVerified bool   `json:"phone_number_verified,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Parse verifies token was signed with algorithm by the owner of publicKey and returns its claims, a token without a
+// subject is rejected since the subject is the caller.
+func Parse(token string, algorithm string, publicKey string) (*SClaims, error) {
+	claims := new(SClaims)
+	if _, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
+		return verificationKey(token.Method, publicKey)
+	}, jwt.WithValidMethods([]string{algorithm}), jwt.WithIssuedAt()); err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		return nil, ErrEmptySubject
+	}
+
+	return claims, nil
+}
+
+func verificationKey(method jwt.SigningMethod, publicKey string) (any, error) {
+	switch method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return []byte(publicKey), nil
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		return jwt.ParseRSAPublicKeyFromPEM([]byte(publicKey))
+	case *jwt.SigningMethodECDSA:
+		return jwt.ParseECPublicKeyFromPEM([]byte(publicKey))
+	case *jwt.SigningMethodEd25519:
+		return jwt.ParseEdPublicKeyFromPEM([]byte(publicKey))
+	default:
+		return nil, jwt.ErrTokenSignatureInvalid
+	}
+}
@@ -0,0 +1,79 @@
+package jwtParser
+
+import (
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+const _secret = "secret"
+
+func sign(t *testing.T, method jwt.SigningMethod, claims SClaims) string {
+	token, err := jwt.NewWithClaims(method, claims).SignedString([]byte(_secret))
+	assert.NoError(t, err)
+	return token
+}
+
+func TestParse(t *testing.T) {
+	tableTests := []struct {
+		name   string
+		method jwt.SigningMethod
+		claims SClaims
+		err    error
+	}{
+		{
+			name:   "valid token",
+			method: jwt.SigningMethodHS256,
+			claims: SClaims{Email: "user@example.com", RegisteredClaims: jwt.RegisteredClaims{Subject: "user", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))}},
+		},
+		{
+			name:   "empty subject",
+			method: jwt.SigningMethodHS256,
+			claims: SClaims{Email: "user@example.com"},
+			err:    ErrEmptySubject,
+		},
+		{
+			name:   "expired token",
+			method: jwt.SigningMethodHS256,
+			claims: SClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user", ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))}},
+			err:    jwt.ErrTokenExpired,
+		},
+		{
+			name:   "other algorithm",
+			method: jwt.SigningMethodHS512,
+			claims: SClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user"}},
+			err:    jwt.ErrTokenSignatureInvalid,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			claims, err := Parse(sign(t, tableTest.method, tableTest.claims), jwt.SigningMethodHS256.Alg(), _secret)
+
+			assert.ErrorIs(t, err, tableTest.err)
+			if tableTest.err != nil {
+				assert.Nil(t, claims)
+				return
+			}
+			assert.Equal(t, tableTest.claims.Subject, claims.Subject)
+			assert.Equal(t, tableTest.claims.Email, claims.Email)
+		})
+	}
+
+	t.Run("concurrent tokens keep their own subject", func(t *testing.T) {
+		var waitGroup sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			waitGroup.Add(1)
+			go func(subject string) {
+				defer waitGroup.Done()
+				claims, err := Parse(sign(t, jwt.SigningMethodHS256, SClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: subject}}), jwt.SigningMethodHS256.Alg(), _secret)
+				assert.NoError(t, err)
+				assert.Equal(t, subject, claims.Subject)
+			}(fmt.Sprint("user-", i))
+		}
+		waitGroup.Wait()
+	})
+}
@@ -0,0 +1,49 @@
+package rateLimiter
+
+import (
+	"sync"
+	"time"
+)
+
+type IRateLimiter interface {
+	Allow(now time.Time) (bool, time.Time)
+}
+
+type sRateLimiter struct {
+	limit  uint
+	window time.Duration
+	events []time.Time
+	mutex  sync.Mutex
+}
+
+// NewRateLimiter allows at most limit events in any sliding window, a zero limit never blocks.
+func NewRateLimiter(limit uint, window time.Duration) IRateLimiter {
+	return &sRateLimiter{
+		limit:  limit,
+		window: window,
+	}
+}
+
+// Allow records an event at now when the limit permits it, otherwise it returns the time the next event is allowed.
+func (r *sRateLimiter) Allow(now time.Time) (bool, time.Time) {
+	if r.limit == 0 {
+		return true, now
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	start := now.Add(-r.window)
+	expired := 0
+	for expired < len(r.events) && !r.events[expired].After(start) {
+		expired++
+	}
+	r.events = r.events[expired:]
+
+	if uint(len(r.events)) >= r.limit {
+		return false, r.events[0].Add(r.window)
+	}
+
+	r.events = append(r.events, now)
+	return true, now
+}
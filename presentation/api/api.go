@@ -64,7 +64,7 @@ func (r *SApi) Start() {
 			}
 		}
 
-		middleware := middlewares.NewMiddleware(r.sConfig, r.iLogger, r.iJwtServer)
+		middleware := middlewares.NewMiddleware(r.application, r.sConfig, r.iLogger, r.iJwtServer)
 		engine.Use(
 			middleware.Cors(),
 			middleware.I18n(),
@@ -75,7 +75,7 @@ func (r *SApi) Start() {
 		{
 			monitoringRouterGroup.GET("/health", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
 			monitoringRouterGroup.GET("/liveness", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
-			monitoringRouterGroup.GET("/readiness", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+			monitoringRouterGroup.GET("/readiness", r.readiness)
 			monitoringRouterGroup.GET("/metrics", gin.WrapH(promhttp.Handler()))
 		}
 
@@ -94,6 +94,16 @@ func (r *SApi) Start() {
 	}
 }
 
+// readiness answers 503 while a dependency is down or the instance is shutting down, the body has the status of every component.
+func (r *SApi) readiness(ctxGin *gin.Context) {
+	readiness := r.application.Readiness.Check(contextplus.FromContext(ctxGin.Request.Context()))
+	if !readiness.IsReady() {
+		ctxGin.JSON(http.StatusServiceUnavailable, readiness)
+		return
+	}
+	ctxGin.JSON(http.StatusOK, readiness)
+}
+
 func (r *SApi) Stop() {
 	if *r.sConfig.Service.Api.IsEnabled {
 		ctx := contextplus.Background()
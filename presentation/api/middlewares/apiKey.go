@@ -0,0 +1,53 @@
+package middlewares
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/gin-gonic/gin"
+	"health-check/application/handlers/commands"
+	"health-check/pkg/apiHandler"
+)
+
+const (
+	_apiKeyHeader     = "X-API-Key"
+	_apiKeyContextKey = "apiKey"
+)
+
+func (r *Middleware) ApiKey() gin.HandlerFunc {
+	return func(ctxGin *gin.Context) {
+		reqCtx := ctxGin.Request.Context()
+		ctx := contextplus.FromContext(reqCtx)
+
+		key := ctxGin.GetHeader(_apiKeyHeader)
+		if len(key) == 0 {
+			r.logger.Warn(ctx, "api key not set in request header")
+			ctxGin.AbortWithStatusJSON(_httpStatusUnauthorized, apiHandler.NewBaseApiResponse(false, apiHandler.NewApiError(_httpStatusUnauthorized, _httpStatusUnauthorizedText)))
+			return
+		}
+
+		apiKey, err := r.application.Commands.ApiKeyAuthenticate.Handle(ctx, commands.NewApiKeyAuthenticateCommand(key))
+		if err != nil {
+			r.logger.WithError(err).Warn(ctx, "api key is invalid")
+			ctxGin.AbortWithStatusJSON(_httpStatusUnauthorized, apiHandler.NewBaseApiResponse(false, apiHandler.NewApiError(_httpStatusUnauthorized, _httpStatusUnauthorizedText)))
+			return
+		}
+
+		ctx.User.SetId(apiKey.TenantId)
+
+		ctxGin.Set(_apiKeyContextKey, apiKey)
+		ctxGin.Request = ctxGin.Request.WithContext(ctx.ToContext())
+		ctxGin.Next()
+	}
+}
+
+// Authenticate accepts either an api key or a bearer token, preferring the api key when both are sent.
+func (r *Middleware) Authenticate() gin.HandlerFunc {
+	apiKey := r.ApiKey()
+	jwt := r.Jwt()
+	return func(ctxGin *gin.Context) {
+		if len(ctxGin.GetHeader(_apiKeyHeader)) != 0 {
+			apiKey(ctxGin)
+			return
+		}
+		jwt(ctxGin)
+	}
+}
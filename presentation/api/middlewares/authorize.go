@@ -0,0 +1,62 @@
+package middlewares
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/gin-contrib/i18n"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"health-check/application/common"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"net/http"
+)
+
+const _tenantIdHeader = "X-Tenant-Id"
+
+// Authorize checks the caller's role in the tenant named by the X-Tenant-Id header, the caller's own tenant when it
+// is not sent, and hands that tenant to the handlers.
+func (r *Middleware) Authorize(permission enums.Permission) gin.HandlerFunc {
+	return func(ctxGin *gin.Context) {
+		reqCtx := ctxGin.Request.Context()
+		ctx := contextplus.FromContext(reqCtx)
+
+		tenantId := ctx.User.Id()
+		if header := ctxGin.GetHeader(_tenantIdHeader); len(header) != 0 {
+			var err error
+			if tenantId, err = uuid.Parse(header); err != nil {
+				r.logger.WithError(err).Warn(ctx, "tenant id is invalid")
+				ctxGin.AbortWithStatusJSON(http.StatusBadRequest, apiHandler.NewBaseApiResponse(false, apiHandler.NewApiError(common.ErrorBadRequest.Code(), i18n.MustGetMessage(ctxGin, common.ErrorBadRequest.Error()))))
+				return
+			}
+		}
+
+		role, err := r.application.Queries.UserRole.Handle(ctx, queries.NewUserRoleQuery(tenantId, ctx.User.Id()))
+		if err != nil {
+			iError, ok := err.(common.IError)
+			if !ok {
+				r.logger.WithError(err).Error(ctx, "error in find user role")
+				iError = common.ErrorInternalServer
+			}
+			ctxGin.AbortWithStatusJSON(http.StatusInternalServerError, apiHandler.NewBaseApiResponse(false, apiHandler.NewApiError(iError.Code(), i18n.MustGetMessage(ctxGin, iError.Error()))))
+			return
+		}
+
+		if value, ok := ctxGin.Get(_apiKeyContextKey); ok && !value.(*entities.ApiKey).Allows(permission) {
+			r.logger.WithAny("permission", permission).Warn(ctx, "permission denied for api key")
+			ctxGin.AbortWithStatusJSON(http.StatusForbidden, apiHandler.NewBaseApiResponse(false, apiHandler.NewApiError(common.ErrorForbidden.Code(), i18n.MustGetMessage(ctxGin, common.ErrorForbidden.Error()))))
+			return
+		}
+
+		if !role.Allows(permission) {
+			r.logger.WithAny("tenantId", tenantId).WithAny("role", role).WithAny("permission", permission).Warn(ctx, "permission denied")
+			ctxGin.AbortWithStatusJSON(http.StatusForbidden, apiHandler.NewBaseApiResponse(false, apiHandler.NewApiError(common.ErrorForbidden.Code(), i18n.MustGetMessage(ctxGin, common.ErrorForbidden.Error()))))
+			return
+		}
+
+		common.SetTenantId(ctx, tenantId)
+		ctxGin.Request = ctxGin.Request.WithContext(ctx.ToContext())
+		ctxGin.Next()
+	}
+}
@@ -0,0 +1,165 @@
+package middlewares
+
+import (
+	"errors"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-contrib/i18n"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+	"gorm.io/datatypes"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type userRoleFunc func(ctx *contextplus.Context, query queries.SUserRoleQuery) (enums.Role, error)
+
+func (r userRoleFunc) Handle(ctx *contextplus.Context, query queries.SUserRoleQuery) (enums.Role, error) {
+	return r(ctx, query)
+}
+
+func TestAuthorize(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tenantId := uuid.New()
+	bindingTenantId := uuid.New()
+
+	type sArg struct {
+		role       enums.Role
+		roleErr    error
+		apiKey     *entities.ApiKey
+		tenantId   string
+		permission enums.Permission
+	}
+	type sOut struct {
+		statusCode int
+	}
+	type sTableTest struct {
+		name string
+		arg  sArg
+		out  sOut
+	}
+
+	tableTests := []sTableTest{
+		{name: "viewer can list", arg: sArg{role: enums.RoleViewer, permission: enums.PermissionList}, out: sOut{statusCode: http.StatusOK}},
+		{name: "viewer can not create", arg: sArg{role: enums.RoleViewer, permission: enums.PermissionCreate}, out: sOut{statusCode: http.StatusForbidden}},
+		{name: "editor can update", arg: sArg{role: enums.RoleEditor, permission: enums.PermissionUpdate}, out: sOut{statusCode: http.StatusOK}},
+		{name: "editor can not manage secrets", arg: sArg{role: enums.RoleEditor, permission: enums.PermissionManageSecrets}, out: sOut{statusCode: http.StatusForbidden}},
+		{name: "admin can manage roles", arg: sArg{role: enums.RoleAdmin, permission: enums.PermissionManageRoles}, out: sOut{statusCode: http.StatusOK}},
+		{name: "unknown role is denied", arg: sArg{role: enums.Role("owner"), permission: enums.PermissionList}, out: sOut{statusCode: http.StatusForbidden}},
+		{
+			name: "api key scope is required even for admin",
+			arg: sArg{
+				role:       enums.RoleAdmin,
+				apiKey:     &entities.ApiKey{Scopes: datatypes.NewJSONType([]enums.Permission{enums.PermissionList})},
+				permission: enums.PermissionDelete,
+			},
+			out: sOut{statusCode: http.StatusForbidden},
+		},
+		{
+			name: "api key scope does not widen the role",
+			arg: sArg{
+				role:       enums.RoleViewer,
+				apiKey:     &entities.ApiKey{Scopes: datatypes.NewJSONType([]enums.Permission{enums.PermissionDelete})},
+				permission: enums.PermissionDelete,
+			},
+			out: sOut{statusCode: http.StatusForbidden},
+		},
+		{
+			name: "api key in scope",
+			arg: sArg{
+				role:       enums.RoleEditor,
+				apiKey:     &entities.ApiKey{Scopes: datatypes.NewJSONType([]enums.Permission{enums.PermissionDelete})},
+				permission: enums.PermissionDelete,
+			},
+			out: sOut{statusCode: http.StatusOK},
+		},
+		{
+			name: "editor bound in another tenant can update it",
+			arg:  sArg{role: enums.RoleEditor, tenantId: bindingTenantId.String(), permission: enums.PermissionUpdate},
+			out:  sOut{statusCode: http.StatusOK},
+		},
+		{
+			name: "user without a binding in another tenant is denied",
+			arg:  sArg{tenantId: bindingTenantId.String(), permission: enums.PermissionList},
+			out:  sOut{statusCode: http.StatusForbidden},
+		},
+		{name: "invalid tenant id", arg: sArg{tenantId: "tenant", permission: enums.PermissionList}, out: sOut{statusCode: http.StatusBadRequest}},
+		{name: "role lookup fails", arg: sArg{roleErr: errors.New("connection refused"), permission: enums.PermissionList}, out: sOut{statusCode: http.StatusInternalServerError}},
+		{name: "role lookup fails with application error", arg: sArg{roleErr: common.ErrorBadRequest, permission: enums.PermissionList}, out: sOut{statusCode: http.StatusInternalServerError}},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			iLogger := logger.NewMockILogger(mockController)
+			iLogger.EXPECT().WithAny(gomock.Any(), gomock.Any()).Return(iLogger).AnyTimes()
+			iLogger.EXPECT().WithError(gomock.Any()).Return(iLogger).AnyTimes()
+			iLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+			iLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			var received queries.SUserRoleQuery
+			middleware := NewMiddleware(&application.Application{
+				Queries: queries.Queries{
+					UserRole: userRoleFunc(func(ctx *contextplus.Context, query queries.SUserRoleQuery) (enums.Role, error) {
+						received = query
+						return tableTest.arg.role, tableTest.arg.roleErr
+					}),
+				},
+			}, nil, iLogger, nil)
+
+			engine := gin.New()
+			engine.Use(i18n.Localize(i18n.WithBundle(&i18n.BundleCfg{
+				RootPath:         "../localize",
+				AcceptLanguage:   []language.Tag{language.English},
+				DefaultLanguage:  language.English,
+				UnmarshalFunc:    yaml.Unmarshal,
+				FormatBundleFile: "yaml",
+			})))
+			engine.Use(func(ctxGin *gin.Context) {
+				ctx := contextplus.Background()
+				ctx.User.SetId(tenantId)
+				if tableTest.arg.apiKey != nil {
+					ctxGin.Set(_apiKeyContextKey, tableTest.arg.apiKey)
+				}
+				ctxGin.Request = ctxGin.Request.WithContext(ctx.ToContext())
+				ctxGin.Next()
+			})
+			var handled uuid.UUID
+			engine.GET("/", middleware.Authorize(tableTest.arg.permission), func(ctxGin *gin.Context) {
+				handled = common.TenantId(contextplus.FromContext(ctxGin.Request.Context()))
+				ctxGin.Status(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tableTest.arg.tenantId != "" {
+				request.Header.Set(_tenantIdHeader, tableTest.arg.tenantId)
+			}
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tableTest.out.statusCode, recorder.Code)
+			if tableTest.out.statusCode == http.StatusBadRequest {
+				assert.Equal(t, queries.SUserRoleQuery{}, received)
+				return
+			}
+			target := tenantId
+			if tableTest.arg.tenantId != "" {
+				target = bindingTenantId
+			}
+			assert.Equal(t, queries.NewUserRoleQuery(target, tenantId), received)
+			if tableTest.out.statusCode == http.StatusOK {
+				assert.Equal(t, target, handled)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"health-check/pkg/apiHandler"
+	"health-check/pkg/jwtParser"
 	"net/http"
 	"strings"
 )
@@ -32,27 +33,28 @@ func (r *Middleware) Jwt() gin.HandlerFunc {
 			return
 		}
 
-		valid, err := r.iJwtServer.VerifyToken(token, "", "")
+		claims, err := jwtParser.Parse(token, r.config.Jwt.Algorithm, r.config.Jwt.PublicKey)
 		if err != nil {
-			r.logger.WithError(err).Error(ctx, "error in Verify authorization token")
+			r.logger.WithError(err).Warn(ctx, "authorization token is invalid")
 			ctxGin.AbortWithStatusJSON(_httpStatusUnauthorized, apiHandler.NewBaseApiResponse(false, apiHandler.NewApiError(_httpStatusUnauthorized, _httpStatusUnauthorizedText)))
 			return
 		}
 
-		if !valid {
-			r.logger.Warn(ctx, "authorization token is invalid")
+		userId, err := uuid.Parse(claims.Subject)
+		if err != nil {
+			r.logger.WithError(err).Warn(ctx, "authorization token subject is not a uuid")
 			ctxGin.AbortWithStatusJSON(_httpStatusUnauthorized, apiHandler.NewBaseApiResponse(false, apiHandler.NewApiError(_httpStatusUnauthorized, _httpStatusUnauthorizedText)))
 			return
 		}
 
-		ctx.User.SetId(uuid.MustParse(r.iJwtServer.GetUserId()))
-		if len(r.iJwtServer.GetEmail()) != 0 {
-			ctx.User.SetEmail(r.iJwtServer.GetEmail())
-			ctx.User.SetEmailVerified(r.iJwtServer.GetEmailVerified())
+		ctx.User.SetId(userId)
+		if len(claims.Email) != 0 {
+			ctx.User.SetEmail(claims.Email)
+			ctx.User.SetEmailVerified(claims.EmailVerified)
 		}
-		if len(r.iJwtServer.GetPhoneNumber()) != 0 {
-			ctx.User.SetPhoneNumber(r.iJwtServer.GetPhoneNumber())
-			ctx.User.SetPhoneNumberVerified(r.iJwtServer.GetPhoneNumberVerified())
+		if len(claims.PhoneNumber) != 0 {
+			ctx.User.SetPhoneNumber(claims.PhoneNumber)
+			ctx.User.SetPhoneNumberVerified(claims.PhoneNumberVerified)
 		}
 
 		ctxGin.Request = ctxGin.Request.WithContext(ctx.ToContext())
@@ -4,19 +4,22 @@ import (
 	"github.com/ehsandavari/go-jwt"
 	"github.com/ehsandavari/go-logger"
 
+	"health-check/application"
 	"health-check/infrastructure/config"
 )
 
 type Middleware struct {
-	config     *config.SConfig
-	logger     logger.ILogger
-	iJwtServer jwt.IJwtServer
+	application *application.Application
+	config      *config.SConfig
+	logger      logger.ILogger
+	iJwtServer  jwt.IJwtServer
 }
 
-func NewMiddleware(config *config.SConfig, logger logger.ILogger, iJwtServer jwt.IJwtServer) *Middleware {
+func NewMiddleware(application *application.Application, config *config.SConfig, logger logger.ILogger, iJwtServer jwt.IJwtServer) *Middleware {
 	return &Middleware{
-		config:     config,
-		logger:     logger,
-		iJwtServer: iJwtServer,
+		application: application,
+		config:      config,
+		logger:      logger,
+		iJwtServer:  iJwtServer,
 	}
 }
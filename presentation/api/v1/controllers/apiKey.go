@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sApiKeyController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewApiKeyController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	apiKeyController := sApiKeyController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/api-key")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionManageApiKeys), apiHandler.BaseController[common.PaginateQuery, *common.PaginateResult[entities.ApiKey]](apiKeyController.list).Handle(apiKeyController.ILogger))
+		routerGroup.POST("/create", middleware.Authorize(enums.PermissionManageApiKeys), apiHandler.BaseController[dtos.ApiKeyCreateRequest, *dtos.ApiKeyCreateResponse](apiKeyController.create).Handle(apiKeyController.ILogger))
+		routerGroup.DELETE("/:id", middleware.Authorize(enums.PermissionManageApiKeys), apiHandler.BaseController[dtos.ApiKeyRevokeRequest, *dtos.ApiKeyRevokeResponse](apiKeyController.revoke).Handle(apiKeyController.ILogger))
+	}
+}
+
+// @Tags		api-key
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string					true	"header"	Enums(en, fa)
+// @Param		params			body		common.PaginateQuery	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.ApiKey]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/api-key/ [POST]
+func (r *sApiKeyController) list(ctx *contextplus.Context, dto common.PaginateQuery) (*common.PaginateResult[entities.ApiKey], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	apiKeys, err := r.application.Queries.ApiKeyPaginate.Handle(ctx, queries.NewApiKeyPaginateQuery(
+		common.TenantId(ctx), dto,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate api keys")
+
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
+
+// @Tags		api-key
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.ApiKeyCreateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.ApiKeyCreateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/api-key/create [POST]
+func (r *sApiKeyController) create(ctx *contextplus.Context, dto dtos.ApiKeyCreateRequest) (*dtos.ApiKeyCreateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	apiKey, err := r.application.Commands.ApiKeyCreate.Handle(ctx, commands.NewApiKeyCreateCommand(
+		common.TenantId(ctx), dto.Name, dto.Scopes, dto.ExpiresAt, ctx.User.Id(),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator create api key")
+
+		return nil, err
+	}
+
+	return &dtos.ApiKeyCreateResponse{
+		Id:        apiKey.Id,
+		Name:      apiKey.Name,
+		Key:       apiKey.Key,
+		Prefix:    apiKey.Prefix,
+		Scopes:    apiKey.Scopes.Data(),
+		ExpiresAt: apiKey.ExpiresAt,
+		CreatedAt: apiKey.CreatedAt,
+	}, nil
+}
+
+// @Tags		api-key
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.ApiKeyRevokeRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.ApiKeyRevokeResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/api-key/:id [DELETE]
+func (r *sApiKeyController) revoke(ctx *contextplus.Context, dto dtos.ApiKeyRevokeRequest) (*dtos.ApiKeyRevokeResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	apiKey, err := r.application.Commands.ApiKeyRevoke.Handle(ctx, commands.NewApiKeyRevokeCommand(
+		common.TenantId(ctx), dto.Id,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator revoke api key")
+
+		return nil, err
+	}
+
+	return &dtos.ApiKeyRevokeResponse{
+		Id: apiKey.Id,
+	}, nil
+}
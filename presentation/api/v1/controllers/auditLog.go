@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sAuditLogController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewAuditLogController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	auditLogController := sAuditLogController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/audit-log")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[dtos.AuditLogPaginateRequest, *common.PaginateResult[entities.AuditLog]](auditLogController.list).Handle(auditLogController.ILogger))
+	}
+}
+
+// @Tags		audit-log
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string							true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.AuditLogPaginateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.AuditLog]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/audit-log/ [POST]
+func (r *sAuditLogController) list(ctx *contextplus.Context, dto dtos.AuditLogPaginateRequest) (*common.PaginateResult[entities.AuditLog], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	auditLogs, err := r.application.Queries.AuditLogPaginate.Handle(ctx, queries.NewAuditLogPaginateQuery(
+		common.TenantId(ctx), dto.PaginateQuery, dto.ActorId, dto.Entity, dto.EntityId, dto.From, dto.To,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate audit logs")
+
+		return nil, err
+	}
+
+	return auditLogs, nil
+}
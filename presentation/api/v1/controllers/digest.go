@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sDigestController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewDigestController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	digestController := sDigestController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/digest")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[common.PaginateQuery, *common.PaginateResult[entities.Digest]](digestController.list).Handle(digestController.ILogger))
+		routerGroup.POST("/create", middleware.Authorize(enums.PermissionManageChannels), apiHandler.BaseController[dtos.DigestCreateRequest, *dtos.DigestCreateResponse](digestController.create).Handle(digestController.ILogger))
+		routerGroup.DELETE("/:id", middleware.Authorize(enums.PermissionManageChannels), apiHandler.BaseController[dtos.DigestDeleteRequest, *dtos.DigestDeleteResponse](digestController.delete).Handle(digestController.ILogger))
+	}
+}
+
+// @Tags		digest
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string					true	"header"	Enums(en, fa)
+// @Param		params			body		common.PaginateQuery	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.Digest]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/digest/ [POST]
+func (r *sDigestController) list(ctx *contextplus.Context, dto common.PaginateQuery) (*common.PaginateResult[entities.Digest], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	digests, err := r.application.Queries.DigestPaginate.Handle(ctx, queries.NewDigestPaginateQuery(
+		common.TenantId(ctx), dto,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate digests")
+
+		return nil, err
+	}
+
+	return digests, nil
+}
+
+// @Tags		digest
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.DigestCreateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.DigestCreateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/digest/create [POST]
+func (r *sDigestController) create(ctx *contextplus.Context, dto dtos.DigestCreateRequest) (*dtos.DigestCreateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	digest, err := r.application.Commands.DigestCreate.Handle(ctx, commands.NewDigestCreateCommand(
+		common.TenantId(ctx), dto.NotificationChannelId, dto.Schedule, dto.Period, dto.Tag, dto.Labels,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator create digest")
+
+		return nil, err
+	}
+
+	return &dtos.DigestCreateResponse{
+		Id:                    digest.Id,
+		NotificationChannelId: digest.NotificationChannelId,
+		Schedule:              digest.Schedule,
+		Period:                digest.Period,
+		Tag:                   digest.Tag,
+		Labels:                digest.Labels.Data(),
+		CreatedAt:             digest.CreatedAt,
+	}, nil
+}
+
+// @Tags		digest
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.DigestDeleteRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.DigestDeleteResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/digest/:id [DELETE]
+func (r *sDigestController) delete(ctx *contextplus.Context, dto dtos.DigestDeleteRequest) (*dtos.DigestDeleteResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	digest, err := r.application.Commands.DigestDelete.Handle(ctx, commands.NewDigestDeleteCommand(
+		common.TenantId(ctx), dto.Id,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator delete digest")
+
+		return nil, err
+	}
+
+	return &dtos.DigestDeleteResponse{
+		Id: digest.Id,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sEscalationPolicyController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewEscalationPolicyController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	escalationPolicyController := sEscalationPolicyController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/escalation-policy")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[common.PaginateQuery, *common.PaginateResult[entities.EscalationPolicy]](escalationPolicyController.list).Handle(escalationPolicyController.ILogger))
+		routerGroup.POST("/create", middleware.Authorize(enums.PermissionManageChannels), apiHandler.BaseController[dtos.EscalationPolicyCreateRequest, *dtos.EscalationPolicyCreateResponse](escalationPolicyController.create).Handle(escalationPolicyController.ILogger))
+		routerGroup.DELETE("/:id", middleware.Authorize(enums.PermissionManageChannels), apiHandler.BaseController[dtos.EscalationPolicyDeleteRequest, *dtos.EscalationPolicyDeleteResponse](escalationPolicyController.delete).Handle(escalationPolicyController.ILogger))
+	}
+}
+
+// @Tags		escalation-policy
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string					true	"header"	Enums(en, fa)
+// @Param		params			body		common.PaginateQuery	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.EscalationPolicy]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/escalation-policy/ [POST]
+func (r *sEscalationPolicyController) list(ctx *contextplus.Context, dto common.PaginateQuery) (*common.PaginateResult[entities.EscalationPolicy], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	escalationPolicies, err := r.application.Queries.EscalationPolicyPaginate.Handle(ctx, queries.NewEscalationPolicyPaginateQuery(
+		common.TenantId(ctx), dto,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate escalation policies")
+
+		return nil, err
+	}
+
+	return escalationPolicies, nil
+}
+
+// @Tags		escalation-policy
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.EscalationPolicyCreateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.EscalationPolicyCreateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/escalation-policy/create [POST]
+func (r *sEscalationPolicyController) create(ctx *contextplus.Context, dto dtos.EscalationPolicyCreateRequest) (*dtos.EscalationPolicyCreateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	escalationPolicy, err := r.application.Commands.EscalationPolicyCreate.Handle(ctx, commands.NewEscalationPolicyCreateCommand(
+		common.TenantId(ctx), dto.Name, dto.EscalationSteps(),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator create escalation policy")
+
+		return nil, err
+	}
+
+	return &dtos.EscalationPolicyCreateResponse{
+		Id:        escalationPolicy.Id,
+		Name:      escalationPolicy.Name,
+		Steps:     escalationPolicy.Steps.Data(),
+		CreatedAt: escalationPolicy.CreatedAt,
+	}, nil
+}
+
+// @Tags		escalation-policy
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.EscalationPolicyDeleteRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.EscalationPolicyDeleteResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/escalation-policy/:id [DELETE]
+func (r *sEscalationPolicyController) delete(ctx *contextplus.Context, dto dtos.EscalationPolicyDeleteRequest) (*dtos.EscalationPolicyDeleteResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	escalationPolicy, err := r.application.Commands.EscalationPolicyDelete.Handle(ctx, commands.NewEscalationPolicyDeleteCommand(
+		common.TenantId(ctx), dto.Id,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator delete escalation policy")
+
+		return nil, err
+	}
+
+	return &dtos.EscalationPolicyDeleteResponse{
+		Id: escalationPolicy.Id,
+	}, nil
+}
@@ -9,8 +9,11 @@ import (
 	"health-check/application/handlers/commands"
 	"health-check/application/handlers/queries"
 	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
 	"health-check/pkg/apiHandler"
 	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
 	"health-check/presentation/api/v1/dtos"
 )
 
@@ -19,7 +22,7 @@ type sHealthCheckController struct {
 	application *application.Application
 }
 
-func NewHealthCheckController(application *application.Application, routerGroup *gin.RouterGroup, iLogger logger.ILogger, iTracer tracer.ITracer) {
+func NewHealthCheckController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
 	healthCheckController := sHealthCheckController{
 		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
 		application:     application,
@@ -27,28 +30,34 @@ func NewHealthCheckController(application *application.Application, routerGroup
 
 	routerGroup = routerGroup.Group("/health-check")
 	{
-		routerGroup.POST("/", apiHandler.BaseController[common.PaginateQuery, *common.PaginateResult[entities.HealthCheck]](healthCheckController.list).Handle(healthCheckController.ILogger))
-		routerGroup.POST("/create", apiHandler.BaseController[dtos.HealthCheckCreateRequest, *dtos.HealthCheckCreateResponse](healthCheckController.create).Handle(healthCheckController.ILogger))
-		routerGroup.PATCH("/:id/:status", apiHandler.BaseController[dtos.HealthCheckStatusRequest, *dtos.HealthCheckStatusResponse](healthCheckController.status).Handle(healthCheckController.ILogger))
-		routerGroup.DELETE("/:id", apiHandler.BaseController[dtos.HealthCheckDeleteRequest, *dtos.HealthCheckDeleteResponse](healthCheckController.delete).Handle(healthCheckController.ILogger))
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[dtos.HealthCheckPaginateRequest, *common.PaginateResult[dtos.HealthCheckResponse]](healthCheckController.list).Handle(healthCheckController.ILogger))
+		routerGroup.POST("/create", middleware.Authorize(enums.PermissionCreate), apiHandler.BaseController[dtos.HealthCheckCreateRequest, *dtos.HealthCheckCreateResponse](healthCheckController.create).Handle(healthCheckController.ILogger))
+		routerGroup.POST("/export", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[dtos.HealthCheckExportRequest, *dtos.HealthCheckExportResponse](healthCheckController.exportDocument).Handle(healthCheckController.ILogger))
+		routerGroup.POST("/import", middleware.Authorize(enums.PermissionCreate), middleware.Authorize(enums.PermissionUpdate), apiHandler.BaseController[dtos.HealthCheckImportRequest, *dtos.HealthCheckImportResponse](healthCheckController.importDocument).Handle(healthCheckController.ILogger))
+		routerGroup.PUT("/:id", middleware.Authorize(enums.PermissionUpdate), apiHandler.BaseController[dtos.HealthCheckUpdateRequest, *dtos.HealthCheckUpdateResponse](healthCheckController.update).Handle(healthCheckController.ILogger))
+		routerGroup.PATCH("/:id/:status", middleware.Authorize(enums.PermissionStatus), apiHandler.BaseController[dtos.HealthCheckStatusRequest, *dtos.HealthCheckStatusResponse](healthCheckController.status).Handle(healthCheckController.ILogger))
+		routerGroup.DELETE("/:id", middleware.Authorize(enums.PermissionDelete), apiHandler.BaseController[dtos.HealthCheckDeleteRequest, *dtos.HealthCheckDeleteResponse](healthCheckController.delete).Handle(healthCheckController.ILogger))
 	}
 }
 
 // @Tags		health-check
 // @Accept		json
 // @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Param		Accept-Language	header		string					true	"header"	Enums(en, fa)
-// @Param		params			body		common.PaginateQuery	true	"body"
-// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.HealthCheck]]
+// @Param		params			body		dtos.HealthCheckPaginateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[dtos.HealthCheckResponse]]
 // @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
 // @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
 // @Router		/health-check/ [POST]
-func (r *sHealthCheckController) list(ctx *contextplus.Context, dto common.PaginateQuery) (*common.PaginateResult[entities.HealthCheck], error) {
+func (r *sHealthCheckController) list(ctx *contextplus.Context, dto dtos.HealthCheckPaginateRequest) (*common.PaginateResult[dtos.HealthCheckResponse], error) {
 	span, ctx := r.ITracer.SpanFromContext(ctx)
 	defer span.Finish()
 
 	healthChecks, err := r.application.Queries.HealthCheckPaginate.Handle(ctx, queries.NewHealthCheckPaginateQuery(
-		dto,
+		common.TenantId(ctx), dto.PaginateQuery, dto.Search, dto.Tags, dto.Labels,
 	))
 	if err != nil {
 		span.SetTag("error", true)
@@ -58,16 +67,32 @@ func (r *sHealthCheckController) list(ctx *contextplus.Context, dto common.Pagin
 		return nil, err
 	}
 
-	return healthChecks, nil
+	items := make([]dtos.HealthCheckResponse, 0, len(healthChecks.Items))
+	for _, healthCheck := range healthChecks.Items {
+		items = append(items, healthCheckResponse(healthCheck))
+	}
+
+	return &common.PaginateResult[dtos.HealthCheckResponse]{
+		Page:       healthChecks.Page,
+		PerPage:    healthChecks.PerPage,
+		TotalPage:  healthChecks.TotalPage,
+		TotalItems: healthChecks.TotalItems,
+		Items:      items,
+	}, nil
 }
 
 // @Tags		health-check
 // @Accept		json
 // @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Param		Accept-Language	header		string							true	"header"	Enums(en, fa)
+// @Param		Idempotency-Key	header		string							false	"replays the original response for a retried request"
 // @Param		params			body		dtos.HealthCheckCreateRequest	true	"body"
 // @Success	200				{object}	apiHandler.BaseApiResponse[dtos.HealthCheckCreateResponse]
 // @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	409				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
 // @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
 // @Router		/health-check/create [POST]
 func (r *sHealthCheckController) create(ctx *contextplus.Context, dto dtos.HealthCheckCreateRequest) (*dtos.HealthCheckCreateResponse, error) {
@@ -75,7 +100,7 @@ func (r *sHealthCheckController) create(ctx *contextplus.Context, dto dtos.Healt
 	defer span.Finish()
 
 	healthCheck, err := r.application.Commands.HealthCheckCreate.Handle(ctx, commands.NewHealthCheckCreateCommand(
-		dto.Interval, dto.Url, dto.Method, dto.Headers, dto.Body,
+		common.TenantId(ctx), dto.IdempotencyKey(), dto.Name, dto.Description, dto.RunbookUrl, dto.Owner, dto.Interval, dto.Url, dto.Method, dto.Headers, dto.Body, dto.Type, dto.Settings, dto.EscalationPolicyId, dto.Tags, dto.Labels,
 	))
 	if err != nil {
 		span.SetTag("error", true)
@@ -86,32 +111,118 @@ func (r *sHealthCheckController) create(ctx *contextplus.Context, dto dtos.Healt
 	}
 
 	return &dtos.HealthCheckCreateResponse{
-		Id:        healthCheck.Id,
-		Interval:  healthCheck.Interval,
-		Url:       healthCheck.Url,
-		Method:    healthCheck.Method,
-		Headers:   healthCheck.Headers.Data(),
-		Body:      healthCheck.Body.Data(),
-		Status:    healthCheck.Status,
-		CreatedAt: healthCheck.CreatedAt,
+		ETagResponse:       dtos.ETagResponse{Version: healthCheck.Version},
+		Id:                 healthCheck.Id,
+		Name:               healthCheck.Name,
+		Description:        healthCheck.Description,
+		RunbookUrl:         healthCheck.RunbookUrl,
+		Owner:              healthCheck.Owner,
+		Interval:           healthCheck.Interval,
+		Url:                healthCheck.Url,
+		Method:             healthCheck.Method,
+		Headers:            valueObjects.RedactHeaders(healthCheck.Headers.Data()),
+		Body:               valueObjects.RedactBody(healthCheck.Body.Data()),
+		Type:               healthCheck.Type,
+		Settings:           healthCheck.Settings.Data(),
+		HeartbeatToken:     healthCheck.HeartbeatToken,
+		Status:             healthCheck.Status,
+		EscalationPolicyId: healthCheck.EscalationPolicyId,
+		Tags:               healthCheck.TagNames(),
+		Labels:             healthCheck.LabelMap(),
+		CreatedAt:          healthCheck.CreatedAt,
+	}, nil
+}
+
+// @Tags		health-check
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string							true	"header"	Enums(en, fa)
+// @Param		If-Match		header		string							false	"version etag"
+// @Param		params			body		dtos.HealthCheckUpdateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.HealthCheckUpdateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	409				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]	"If-Match does not match the version or another request changed the health check"
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/health-check/:id [PUT]
+func (r *sHealthCheckController) update(ctx *contextplus.Context, dto dtos.HealthCheckUpdateRequest) (*dtos.HealthCheckUpdateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	version, err := dto.Version()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Warn(ctx, "error in parse If-Match header")
+
+		return nil, common.ErrorBadRequest
+	}
+
+	healthCheck, err := r.application.Commands.HealthCheckUpdate.Handle(ctx, commands.NewHealthCheckUpdateCommand(
+		common.TenantId(ctx), dto.Id, version, dto.Name, dto.Description, dto.RunbookUrl, dto.Owner, dto.Interval, dto.Url, dto.Method, dto.Headers, dto.Body, dto.Type, dto.Settings, dto.EscalationPolicyId, dto.Tags, dto.Labels,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator update health check")
+
+		return nil, err
+	}
+
+	return &dtos.HealthCheckUpdateResponse{
+		ETagResponse:       dtos.ETagResponse{Version: healthCheck.Version},
+		Id:                 healthCheck.Id,
+		Name:               healthCheck.Name,
+		Description:        healthCheck.Description,
+		RunbookUrl:         healthCheck.RunbookUrl,
+		Owner:              healthCheck.Owner,
+		Interval:           healthCheck.Interval,
+		Url:                healthCheck.Url,
+		Method:             healthCheck.Method,
+		Headers:            valueObjects.RedactHeaders(healthCheck.Headers.Data()),
+		Body:               valueObjects.RedactBody(healthCheck.Body.Data()),
+		Type:               healthCheck.Type,
+		Settings:           healthCheck.Settings.Data(),
+		HeartbeatToken:     healthCheck.HeartbeatToken,
+		Status:             healthCheck.Status,
+		EscalationPolicyId: healthCheck.EscalationPolicyId,
+		Tags:               healthCheck.TagNames(),
+		Labels:             healthCheck.LabelMap(),
+		UpdatedAt:          healthCheck.UpdatedAt,
 	}, nil
 }
 
 // @Tags		health-check
 // @Accept		json
 // @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Param		Accept-Language	header		string							true	"header"	Enums(en, fa)
+// @Param		If-Match		header		string							false	"version etag"
 // @Param		params			body		dtos.HealthCheckStatusRequest	true	"body"
 // @Success	200				{object}	apiHandler.BaseApiResponse[dtos.HealthCheckStatusResponse]
 // @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	409				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]	"If-Match does not match the version or another request changed the health check"
 // @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
 // @Router		/health-check/:id/:status [PATCH]
 func (r *sHealthCheckController) status(ctx *contextplus.Context, dto dtos.HealthCheckStatusRequest) (*dtos.HealthCheckStatusResponse, error) {
 	span, ctx := r.ITracer.SpanFromContext(ctx)
 	defer span.Finish()
 
+	version, err := dto.Version()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Warn(ctx, "error in parse If-Match header")
+
+		return nil, common.ErrorBadRequest
+	}
+
 	healthCheck, err := r.application.Commands.HealthCheckStatus.Handle(ctx, commands.NewHealthCheckStatusCommand(
-		dto.Id, dto.Status,
+		common.TenantId(ctx), dto.Id, version, dto.Status,
 	))
 	if err != nil {
 		span.SetTag("error", true)
@@ -122,27 +233,42 @@ func (r *sHealthCheckController) status(ctx *contextplus.Context, dto dtos.Healt
 	}
 
 	return &dtos.HealthCheckStatusResponse{
-		Id:        healthCheck.Id,
-		Status:    healthCheck.Status,
-		UpdatedAt: healthCheck.UpdatedAt,
+		ETagResponse: dtos.ETagResponse{Version: healthCheck.Version},
+		Id:           healthCheck.Id,
+		Status:       healthCheck.Status,
+		UpdatedAt:    healthCheck.UpdatedAt,
 	}, nil
 }
 
 // @Tags		health-check
 // @Accept		json
 // @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
 // @Param		Accept-Language	header		string							true	"header"	Enums(en, fa)
+// @Param		If-Match		header		string							false	"version etag"
 // @Param		params			body		dtos.HealthCheckDeleteRequest	true	"body"
 // @Success	200				{object}	apiHandler.BaseApiResponse[dtos.HealthCheckDeleteResponse]
 // @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	409				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]	"If-Match does not match the version or another request changed the health check"
 // @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
 // @Router		/health-check/:id [DELETE]
 func (r *sHealthCheckController) delete(ctx *contextplus.Context, dto dtos.HealthCheckDeleteRequest) (*dtos.HealthCheckDeleteResponse, error) {
 	span, ctx := r.ITracer.SpanFromContext(ctx)
 	defer span.Finish()
 
+	version, err := dto.Version()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Warn(ctx, "error in parse If-Match header")
+
+		return nil, common.ErrorBadRequest
+	}
+
 	healthCheck, err := r.application.Commands.HealthCheckDelete.Handle(ctx, commands.NewHealthCheckDeleteCommand(
-		dto.Id,
+		common.TenantId(ctx), dto.Id, version,
 	))
 	if err != nil {
 		span.SetTag("error", true)
@@ -156,3 +282,114 @@ func (r *sHealthCheckController) delete(ctx *contextplus.Context, dto dtos.Healt
 		Id: healthCheck.Id,
 	}, nil
 }
+
+// @Tags		health-check
+// @Accept		json
+// @Produce	json,x-yaml
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string							true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.HealthCheckExportRequest	true	"body"
+// @Success	200				{object}	dtos.HealthCheckExportResponse
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/health-check/export [POST]
+func (r *sHealthCheckController) exportDocument(ctx *contextplus.Context, dto dtos.HealthCheckExportRequest) (*dtos.HealthCheckExportResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	definitions, err := r.application.Queries.HealthCheckExport.Handle(ctx, queries.NewHealthCheckExportQuery(
+		common.TenantId(ctx), dto.Search, dto.Tags, dto.Labels,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator export health checks")
+
+		return nil, err
+	}
+
+	healthChecks := make([]dtos.HealthCheckDefinition, 0, len(definitions))
+	for _, definition := range definitions {
+		healthChecks = append(healthChecks, dtos.HealthCheckDefinition(definition))
+	}
+
+	return dtos.NewHealthCheckExportResponse(dto.Format, healthChecks), nil
+}
+
+// @Tags		health-check
+// @Accept		json,x-yaml
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string							true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.HealthCheckImportRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.HealthCheckImportResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	409				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/health-check/import [POST]
+func (r *sHealthCheckController) importDocument(ctx *contextplus.Context, dto dtos.HealthCheckImportRequest) (*dtos.HealthCheckImportResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	definitions := make([]valueObjects.HealthCheckDefinition, 0, len(dto.HealthChecks))
+	for _, healthCheck := range dto.HealthChecks {
+		definitions = append(definitions, valueObjects.HealthCheckDefinition(healthCheck))
+	}
+
+	changes, err := r.application.Commands.HealthCheckImport.Handle(ctx, commands.NewHealthCheckImportCommand(
+		common.TenantId(ctx), dto.DryRun, false, "", definitions,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator import health checks")
+
+		return nil, err
+	}
+
+	response := &dtos.HealthCheckImportResponse{
+		DryRun:  dto.DryRun,
+		Changes: make([]dtos.HealthCheckImportChange, 0, len(changes)),
+	}
+	for _, change := range changes {
+		response.Changes = append(response.Changes, dtos.HealthCheckImportChange{
+			Id:     change.Id,
+			Owner:  change.Owner,
+			Name:   change.Name,
+			Action: change.Action,
+		})
+	}
+
+	return response, nil
+}
+
+func healthCheckResponse(healthCheck entities.HealthCheck) dtos.HealthCheckResponse {
+	return dtos.HealthCheckResponse{
+		Id:                 healthCheck.Id,
+		Name:               healthCheck.Name,
+		Description:        healthCheck.Description,
+		RunbookUrl:         healthCheck.RunbookUrl,
+		Owner:              healthCheck.Owner,
+		Interval:           healthCheck.Interval,
+		Url:                healthCheck.Url,
+		Method:             healthCheck.Method,
+		Headers:            valueObjects.RedactHeaders(healthCheck.Headers.Data()),
+		Body:               valueObjects.RedactBody(healthCheck.Body.Data()),
+		Type:               healthCheck.Type,
+		Settings:           healthCheck.Settings.Data(),
+		HeartbeatToken:     healthCheck.HeartbeatToken,
+		Status:             healthCheck.Status,
+		EscalationPolicyId: healthCheck.EscalationPolicyId,
+		Flapping:           healthCheck.Flapping,
+		Tags:               healthCheck.TagNames(),
+		Labels:             healthCheck.LabelMap(),
+		Version:            healthCheck.Version,
+		Managed:            healthCheck.Managed,
+		CreatedAt:          healthCheck.CreatedAt,
+		UpdatedAt:          healthCheck.UpdatedAt,
+	}
+}
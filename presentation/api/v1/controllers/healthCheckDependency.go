@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"go/types"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sHealthCheckDependencyController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewHealthCheckDependencyController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	healthCheckDependencyController := sHealthCheckDependencyController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/health-check-dependency")
+	{
+		routerGroup.GET("/graph", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[*types.Nil, *valueObjects.DependencyGraph](healthCheckDependencyController.graph).Handle(healthCheckDependencyController.ILogger))
+		routerGroup.POST("/create", middleware.Authorize(enums.PermissionCreate), apiHandler.BaseController[dtos.HealthCheckDependencyCreateRequest, *dtos.HealthCheckDependencyCreateResponse](healthCheckDependencyController.create).Handle(healthCheckDependencyController.ILogger))
+		routerGroup.DELETE("/:id", middleware.Authorize(enums.PermissionDelete), apiHandler.BaseController[dtos.HealthCheckDependencyDeleteRequest, *dtos.HealthCheckDependencyDeleteResponse](healthCheckDependencyController.delete).Handle(healthCheckDependencyController.ILogger))
+	}
+}
+
+// @Tags		health-check-dependency
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string	true	"header"	Enums(en, fa)
+// @Success	200				{object}	apiHandler.BaseApiResponse[valueObjects.DependencyGraph]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/health-check-dependency/graph [GET]
+func (r *sHealthCheckDependencyController) graph(ctx *contextplus.Context, _ *types.Nil) (*valueObjects.DependencyGraph, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	dependencyGraph, err := r.application.Queries.HealthCheckDependencyGraph.Handle(ctx, queries.NewHealthCheckDependencyGraphQuery(common.TenantId(ctx)))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).Error(ctx, "error in send mediator health check dependency graph")
+
+		return nil, err
+	}
+
+	return dependencyGraph, nil
+}
+
+// @Tags		health-check-dependency
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.HealthCheckDependencyCreateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.HealthCheckDependencyCreateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/health-check-dependency/create [POST]
+func (r *sHealthCheckDependencyController) create(ctx *contextplus.Context, dto dtos.HealthCheckDependencyCreateRequest) (*dtos.HealthCheckDependencyCreateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	healthCheckDependency, err := r.application.Commands.HealthCheckDependencyCreate.Handle(ctx, commands.NewHealthCheckDependencyCreateCommand(
+		common.TenantId(ctx), dto.HealthCheckId, dto.ParentId,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator create health check dependency")
+
+		return nil, err
+	}
+
+	return &dtos.HealthCheckDependencyCreateResponse{
+		Id:            healthCheckDependency.Id,
+		HealthCheckId: healthCheckDependency.HealthCheckId,
+		ParentId:      healthCheckDependency.ParentId,
+		CreatedAt:     healthCheckDependency.CreatedAt,
+	}, nil
+}
+
+// @Tags		health-check-dependency
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.HealthCheckDependencyDeleteRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.HealthCheckDependencyDeleteResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/health-check-dependency/:id [DELETE]
+func (r *sHealthCheckDependencyController) delete(ctx *contextplus.Context, dto dtos.HealthCheckDependencyDeleteRequest) (*dtos.HealthCheckDependencyDeleteResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	healthCheckDependency, err := r.application.Commands.HealthCheckDependencyDelete.Handle(ctx, commands.NewHealthCheckDependencyDeleteCommand(
+		common.TenantId(ctx), dto.Id,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator delete health check dependency")
+
+		return nil, err
+	}
+
+	return &dtos.HealthCheckDependencyDeleteResponse{
+		Id: healthCheckDependency.Id,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/handlers/commands"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sHeartbeatController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+// NewHeartbeatController serves the ping urls of heartbeat checks, the token in the url is the only credential so the
+// routes are registered outside the authenticated group.
+func NewHeartbeatController(application *application.Application, routerGroup *gin.RouterGroup, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	heartbeatController := sHeartbeatController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/heartbeat")
+	{
+		routerGroup.POST("/:token", apiHandler.BaseController[dtos.HeartbeatPingRequest, *dtos.HeartbeatPingResponse](heartbeatController.ping).Handle(heartbeatController.ILogger))
+		routerGroup.POST("/:token/:signal", apiHandler.BaseController[dtos.HeartbeatPingRequest, *dtos.HeartbeatPingResponse](heartbeatController.ping).Handle(heartbeatController.ILogger))
+	}
+}
+
+// @Tags		heartbeat
+// @Accept		plain
+// @Produce	json
+// @Param		Accept-Language	header		string	true	"header"	Enums(en, fa)
+// @Param		token			path		string	true	"heartbeat token of the health check"
+// @Param		signal			path		string	false	"defaults to success"	Enums(start, success, fail)
+// @Param		log				body		string	false	"output of the run, kept up to 10000 bytes"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.HeartbeatPingResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/heartbeat/:token/:signal [POST]
+func (r *sHeartbeatController) ping(ctx *contextplus.Context, dto dtos.HeartbeatPingRequest) (*dtos.HeartbeatPingResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	healthCheckRequest, err := r.application.Commands.HeartbeatPing.Handle(ctx, commands.NewHeartbeatPingCommand(dto.Token, dto.Signal, dto.Log))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithString("signal", dto.Signal.String()).Error(ctx, "error in send mediator heartbeat ping")
+
+		return nil, err
+	}
+
+	return &dtos.HeartbeatPingResponse{
+		Id:     healthCheckRequest.Id,
+		Status: healthCheckRequest.Status,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sIncidentController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewIncidentController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	incidentController := sIncidentController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/incident")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[common.PaginateQuery, *common.PaginateResult[entities.Incident]](incidentController.list).Handle(incidentController.ILogger))
+		routerGroup.PATCH("/:id/acknowledge", middleware.Authorize(enums.PermissionUpdate), apiHandler.BaseController[dtos.IncidentAcknowledgeRequest, *dtos.IncidentAcknowledgeResponse](incidentController.acknowledge).Handle(incidentController.ILogger))
+	}
+}
+
+// @Tags		incident
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string					true	"header"	Enums(en, fa)
+// @Param		params			body		common.PaginateQuery	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.Incident]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/incident/ [POST]
+func (r *sIncidentController) list(ctx *contextplus.Context, dto common.PaginateQuery) (*common.PaginateResult[entities.Incident], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	incidents, err := r.application.Queries.IncidentPaginate.Handle(ctx, queries.NewIncidentPaginateQuery(
+		common.TenantId(ctx), dto,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate incidents")
+
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+// @Tags		incident
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string							true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.IncidentAcknowledgeRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.IncidentAcknowledgeResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/incident/:id/acknowledge [PATCH]
+func (r *sIncidentController) acknowledge(ctx *contextplus.Context, dto dtos.IncidentAcknowledgeRequest) (*dtos.IncidentAcknowledgeResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	incident, err := r.application.Commands.IncidentAcknowledge.Handle(ctx, commands.NewIncidentAcknowledgeCommand(
+		common.TenantId(ctx), dto.Id, ctx.User.Id(),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator acknowledge incident")
+
+		return nil, err
+	}
+
+	return &dtos.IncidentAcknowledgeResponse{
+		Id:             incident.Id,
+		Status:         incident.Status,
+		AcknowledgedAt: incident.AcknowledgedAt,
+		AcknowledgedBy: incident.AcknowledgedBy,
+	}, nil
+}
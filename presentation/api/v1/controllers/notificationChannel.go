@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sNotificationChannelController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewNotificationChannelController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	notificationChannelController := sNotificationChannelController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/notification-channel")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[common.PaginateQuery, *common.PaginateResult[entities.NotificationChannel]](notificationChannelController.list).Handle(notificationChannelController.ILogger))
+		routerGroup.POST("/create", middleware.Authorize(enums.PermissionManageChannels), apiHandler.BaseController[dtos.NotificationChannelCreateRequest, *dtos.NotificationChannelCreateResponse](notificationChannelController.create).Handle(notificationChannelController.ILogger))
+		routerGroup.DELETE("/:id", middleware.Authorize(enums.PermissionManageChannels), apiHandler.BaseController[dtos.NotificationChannelDeleteRequest, *dtos.NotificationChannelDeleteResponse](notificationChannelController.delete).Handle(notificationChannelController.ILogger))
+	}
+}
+
+// @Tags		notification-channel
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string					true	"header"	Enums(en, fa)
+// @Param		params			body		common.PaginateQuery	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.NotificationChannel]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/notification-channel/ [POST]
+func (r *sNotificationChannelController) list(ctx *contextplus.Context, dto common.PaginateQuery) (*common.PaginateResult[entities.NotificationChannel], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	notificationChannels, err := r.application.Queries.NotificationChannelPaginate.Handle(ctx, queries.NewNotificationChannelPaginateQuery(
+		common.TenantId(ctx), dto,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate notification channels")
+
+		return nil, err
+	}
+
+	return notificationChannels, nil
+}
+
+// @Tags		notification-channel
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.NotificationChannelCreateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.NotificationChannelCreateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/notification-channel/create [POST]
+func (r *sNotificationChannelController) create(ctx *contextplus.Context, dto dtos.NotificationChannelCreateRequest) (*dtos.NotificationChannelCreateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	notificationChannel, err := r.application.Commands.NotificationChannelCreate.Handle(ctx, commands.NewNotificationChannelCreateCommand(
+		common.TenantId(ctx), dto.Name, dto.Provider, dto.Receiver, dto.Labels,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator create notification channel")
+
+		return nil, err
+	}
+
+	return &dtos.NotificationChannelCreateResponse{
+		Id:        notificationChannel.Id,
+		Name:      notificationChannel.Name,
+		Provider:  notificationChannel.Provider,
+		Receiver:  notificationChannel.Receiver,
+		Labels:    notificationChannel.Labels.Data(),
+		CreatedAt: notificationChannel.CreatedAt,
+	}, nil
+}
+
+// @Tags		notification-channel
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.NotificationChannelDeleteRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.NotificationChannelDeleteResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/notification-channel/:id [DELETE]
+func (r *sNotificationChannelController) delete(ctx *contextplus.Context, dto dtos.NotificationChannelDeleteRequest) (*dtos.NotificationChannelDeleteResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	notificationChannel, err := r.application.Commands.NotificationChannelDelete.Handle(ctx, commands.NewNotificationChannelDeleteCommand(
+		common.TenantId(ctx), dto.Id,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator delete notification channel")
+
+		return nil, err
+	}
+
+	return &dtos.NotificationChannelDeleteResponse{
+		Id: notificationChannel.Id,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sNotificationDeliveryController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewNotificationDeliveryController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	notificationDeliveryController := sNotificationDeliveryController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/notification-delivery")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[dtos.NotificationDeliveryPaginateRequest, *common.PaginateResult[entities.NotificationDelivery]](notificationDeliveryController.list).Handle(notificationDeliveryController.ILogger))
+		routerGroup.PATCH("/:id/retry", middleware.Authorize(enums.PermissionManageChannels), apiHandler.BaseController[dtos.NotificationDeliveryRetryRequest, *dtos.NotificationDeliveryRetryResponse](notificationDeliveryController.retry).Handle(notificationDeliveryController.ILogger))
+	}
+}
+
+// @Tags		notification-delivery
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string										true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.NotificationDeliveryPaginateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.NotificationDelivery]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/notification-delivery/ [POST]
+func (r *sNotificationDeliveryController) list(ctx *contextplus.Context, dto dtos.NotificationDeliveryPaginateRequest) (*common.PaginateResult[entities.NotificationDelivery], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	notificationDeliveries, err := r.application.Queries.NotificationDeliveryPaginate.Handle(ctx, queries.NewNotificationDeliveryPaginateQuery(
+		common.TenantId(ctx), dto.PaginateQuery, dto.Statuses,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate notification deliveries")
+
+		return nil, err
+	}
+
+	return notificationDeliveries, nil
+}
+
+// @Tags		notification-delivery
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string	true	"header"	Enums(en, fa)
+// @Param		id				path		int		true	"notification delivery id"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.NotificationDeliveryRetryResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/notification-delivery/:id/retry [PATCH]
+func (r *sNotificationDeliveryController) retry(ctx *contextplus.Context, dto dtos.NotificationDeliveryRetryRequest) (*dtos.NotificationDeliveryRetryResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	notificationDelivery, err := r.application.Commands.NotificationDeliveryRetry.Handle(ctx, commands.NewNotificationDeliveryRetryCommand(
+		common.TenantId(ctx), dto.Id,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator retry notification delivery")
+
+		return nil, err
+	}
+
+	return &dtos.NotificationDeliveryRetryResponse{
+		Id:            notificationDelivery.Id,
+		Status:        notificationDelivery.Status,
+		NextAttemptAt: notificationDelivery.NextAttemptAt,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sRoleBindingController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewRoleBindingController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	roleBindingController := sRoleBindingController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/role-binding")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionManageRoles), apiHandler.BaseController[common.PaginateQuery, *common.PaginateResult[entities.RoleBinding]](roleBindingController.list).Handle(roleBindingController.ILogger))
+		routerGroup.POST("/create", middleware.Authorize(enums.PermissionManageRoles), apiHandler.BaseController[dtos.RoleBindingCreateRequest, *dtos.RoleBindingCreateResponse](roleBindingController.create).Handle(roleBindingController.ILogger))
+		routerGroup.DELETE("/:id", middleware.Authorize(enums.PermissionManageRoles), apiHandler.BaseController[dtos.RoleBindingDeleteRequest, *dtos.RoleBindingDeleteResponse](roleBindingController.delete).Handle(roleBindingController.ILogger))
+	}
+}
+
+// @Tags		role-binding
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string					true	"header"	Enums(en, fa)
+// @Param		params			body		common.PaginateQuery	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.RoleBinding]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/role-binding/ [POST]
+func (r *sRoleBindingController) list(ctx *contextplus.Context, dto common.PaginateQuery) (*common.PaginateResult[entities.RoleBinding], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	roleBindings, err := r.application.Queries.RoleBindingPaginate.Handle(ctx, queries.NewRoleBindingPaginateQuery(
+		common.TenantId(ctx), dto,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate role bindings")
+
+		return nil, err
+	}
+
+	return roleBindings, nil
+}
+
+// @Tags		role-binding
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.RoleBindingCreateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.RoleBindingCreateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/role-binding/create [POST]
+func (r *sRoleBindingController) create(ctx *contextplus.Context, dto dtos.RoleBindingCreateRequest) (*dtos.RoleBindingCreateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	roleBinding, err := r.application.Commands.RoleBindingCreate.Handle(ctx, commands.NewRoleBindingCreateCommand(
+		common.TenantId(ctx), dto.UserId, dto.Role, ctx.User.Id(),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator create role binding")
+
+		return nil, err
+	}
+
+	return &dtos.RoleBindingCreateResponse{
+		Id:        roleBinding.Id,
+		UserId:    roleBinding.UserId,
+		Role:      roleBinding.Role,
+		CreatedAt: roleBinding.CreatedAt,
+	}, nil
+}
+
+// @Tags		role-binding
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.RoleBindingDeleteRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.RoleBindingDeleteResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/role-binding/:id [DELETE]
+func (r *sRoleBindingController) delete(ctx *contextplus.Context, dto dtos.RoleBindingDeleteRequest) (*dtos.RoleBindingDeleteResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	roleBinding, err := r.application.Commands.RoleBindingDelete.Handle(ctx, commands.NewRoleBindingDeleteCommand(
+		common.TenantId(ctx), dto.Id,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator delete role binding")
+
+		return nil, err
+	}
+
+	return &dtos.RoleBindingDeleteResponse{
+		Id: roleBinding.Id,
+	}, nil
+}
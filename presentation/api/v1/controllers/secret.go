@@ -0,0 +1,173 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+)
+
+type sSecretController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+// NewSecretController registers the secret endpoints, they only ever answer with the metadata of a secret, the value is
+// write only and the dtos holding it are never logged.
+func NewSecretController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	secretController := sSecretController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/secret")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[common.PaginateQuery, *common.PaginateResult[entities.Secret]](secretController.list).Handle(secretController.ILogger))
+		routerGroup.POST("/create", middleware.Authorize(enums.PermissionManageSecrets), apiHandler.BaseController[dtos.SecretCreateRequest, *dtos.SecretCreateResponse](secretController.create).Handle(secretController.ILogger))
+		routerGroup.PUT("/:id", middleware.Authorize(enums.PermissionManageSecrets), apiHandler.BaseController[dtos.SecretUpdateRequest, *dtos.SecretUpdateResponse](secretController.update).Handle(secretController.ILogger))
+		routerGroup.DELETE("/:id", middleware.Authorize(enums.PermissionManageSecrets), apiHandler.BaseController[dtos.SecretDeleteRequest, *dtos.SecretDeleteResponse](secretController.delete).Handle(secretController.ILogger))
+	}
+}
+
+// @Tags		secret
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string					true	"header"	Enums(en, fa)
+// @Param		params			body		common.PaginateQuery	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.Secret]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/secret/ [POST]
+func (r *sSecretController) list(ctx *contextplus.Context, dto common.PaginateQuery) (*common.PaginateResult[entities.Secret], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	secrets, err := r.application.Queries.SecretPaginate.Handle(ctx, queries.NewSecretPaginateQuery(
+		common.TenantId(ctx), dto,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate secrets")
+
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+// @Tags		secret
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string						true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.SecretCreateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.SecretCreateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/secret/create [POST]
+func (r *sSecretController) create(ctx *contextplus.Context, dto dtos.SecretCreateRequest) (*dtos.SecretCreateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	secret, err := r.application.Commands.SecretCreate.Handle(ctx, commands.NewSecretCreateCommand(
+		common.TenantId(ctx), dto.Name, dto.Description, dto.Value,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithString("name", dto.Name).Error(ctx, "error in send mediator create secret")
+
+		return nil, err
+	}
+
+	return &dtos.SecretCreateResponse{
+		Id:          secret.Id,
+		Name:        secret.Name,
+		Description: secret.Description,
+		CreatedAt:   secret.CreatedAt,
+	}, nil
+}
+
+// @Tags		secret
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string						true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.SecretUpdateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.SecretUpdateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	404				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/secret/:id [PUT]
+func (r *sSecretController) update(ctx *contextplus.Context, dto dtos.SecretUpdateRequest) (*dtos.SecretUpdateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	secret, err := r.application.Commands.SecretUpdate.Handle(ctx, commands.NewSecretUpdateCommand(
+		common.TenantId(ctx), dto.Id, dto.Description, dto.Value,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithUint("id", dto.Id).Error(ctx, "error in send mediator update secret")
+
+		return nil, err
+	}
+
+	return &dtos.SecretUpdateResponse{
+		Id:          secret.Id,
+		Name:        secret.Name,
+		Description: secret.Description,
+		UpdatedAt:   secret.UpdatedAt,
+	}, nil
+}
+
+// @Tags		secret
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string						true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.SecretDeleteRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.SecretDeleteResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	404				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/secret/:id [DELETE]
+func (r *sSecretController) delete(ctx *contextplus.Context, dto dtos.SecretDeleteRequest) (*dtos.SecretDeleteResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	secret, err := r.application.Commands.SecretDelete.Handle(ctx, commands.NewSecretDeleteCommand(
+		common.TenantId(ctx), dto.Id,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator delete secret")
+
+		return nil, err
+	}
+
+	return &dtos.SecretDeleteResponse{
+		Id: secret.Id,
+	}, nil
+}
@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/gin-gonic/gin"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/apiHandler"
+	"health-check/pkg/tracer"
+	"health-check/presentation/api/middlewares"
+	"health-check/presentation/api/v1/dtos"
+	"time"
+)
+
+type sSilenceController struct {
+	apiHandler.SBaseController
+	application *application.Application
+}
+
+func NewSilenceController(application *application.Application, routerGroup *gin.RouterGroup, middleware *middlewares.Middleware, iLogger logger.ILogger, iTracer tracer.ITracer) {
+	silenceController := sSilenceController{
+		SBaseController: apiHandler.NewBaseController(iLogger, iTracer),
+		application:     application,
+	}
+
+	routerGroup = routerGroup.Group("/silence")
+	{
+		routerGroup.POST("/", middleware.Authorize(enums.PermissionList), apiHandler.BaseController[common.PaginateQuery, *common.PaginateResult[entities.Silence]](silenceController.list).Handle(silenceController.ILogger))
+		routerGroup.POST("/create", middleware.Authorize(enums.PermissionCreate), apiHandler.BaseController[dtos.SilenceCreateRequest, *dtos.SilenceCreateResponse](silenceController.create).Handle(silenceController.ILogger))
+		routerGroup.PATCH("/:id/expire", middleware.Authorize(enums.PermissionUpdate), apiHandler.BaseController[dtos.SilenceExpireRequest, *dtos.SilenceExpireResponse](silenceController.expire).Handle(silenceController.ILogger))
+	}
+}
+
+// @Tags		silence
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string					true	"header"	Enums(en, fa)
+// @Param		params			body		common.PaginateQuery	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[common.PaginateResult[entities.Silence]]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/silence/ [POST]
+func (r *sSilenceController) list(ctx *contextplus.Context, dto common.PaginateQuery) (*common.PaginateResult[entities.Silence], error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	silences, err := r.application.Queries.SilencePaginate.Handle(ctx, queries.NewSilencePaginateQuery(
+		common.TenantId(ctx), dto,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator paginate silences")
+
+		return nil, err
+	}
+
+	return silences, nil
+}
+
+// @Tags		silence
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.SilenceCreateRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.SilenceCreateResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/silence/create [POST]
+func (r *sSilenceController) create(ctx *contextplus.Context, dto dtos.SilenceCreateRequest) (*dtos.SilenceCreateResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	silence, err := r.application.Commands.SilenceCreate.Handle(ctx, commands.NewSilenceCreateCommand(
+		common.TenantId(ctx), dto.HealthCheckId, dto.Tag, dto.Labels, dto.Reason, time.Duration(dto.DurationMinute)*time.Minute, ctx.User.Id(),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator create silence")
+
+		return nil, err
+	}
+
+	return &dtos.SilenceCreateResponse{
+		Id:            silence.Id,
+		HealthCheckId: silence.HealthCheckId,
+		Tag:           silence.Tag,
+		Labels:        silence.Labels.Data(),
+		Reason:        silence.Reason,
+		CreatedBy:     silence.CreatedBy,
+		StartsAt:      silence.StartsAt,
+		EndsAt:        silence.EndsAt,
+	}, nil
+}
+
+// @Tags		silence
+// @Accept		json
+// @Produce	json
+// @Security	BearerAuth
+// @Security	ApiKeyAuth
+// @Param		Accept-Language	header		string									true	"header"	Enums(en, fa)
+// @Param		params			body		dtos.SilenceExpireRequest	true	"body"
+// @Success	200				{object}	apiHandler.BaseApiResponse[dtos.SilenceExpireResponse]
+// @Failure	400				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	403				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Failure	500				{object}	apiHandler.BaseApiResponse[apiHandler.ApiError]
+// @Router		/silence/:id/expire [PATCH]
+func (r *sSilenceController) expire(ctx *contextplus.Context, dto dtos.SilenceExpireRequest) (*dtos.SilenceExpireResponse, error) {
+	span, ctx := r.ITracer.SpanFromContext(ctx)
+	defer span.Finish()
+
+	silence, err := r.application.Commands.SilenceExpire.Handle(ctx, commands.NewSilenceExpireCommand(
+		common.TenantId(ctx), dto.Id,
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.ILogger.WithError(err).WithAny("dto", dto).Error(ctx, "error in send mediator expire silence")
+
+		return nil, err
+	}
+
+	return &dtos.SilenceExpireResponse{
+		Id:     silence.Id,
+		EndsAt: silence.EndsAt,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package dtos
+
+import (
+	"health-check/domain/enums"
+	"time"
+)
+
+type ApiKeyCreateRequest struct {
+	Name      string             `binding:"required,max=100" example:"terraform"`
+	Scopes    []enums.Permission `binding:"required,min=1,dive,enum" example:"list,create,status"`
+	ExpiresAt *time.Time
+}
+
+type ApiKeyCreateResponse struct {
+	Id        uint
+	Name      string
+	Key       string
+	Prefix    string
+	Scopes    []enums.Permission
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
+
+type ApiKeyRevokeRequest struct {
+	Id uint `binding:"required"`
+}
+
+type ApiKeyRevokeResponse struct {
+	Id uint
+}
@@ -0,0 +1,16 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+	"health-check/application/common"
+	"time"
+)
+
+type AuditLogPaginateRequest struct {
+	common.PaginateQuery
+	ActorId  *uuid.UUID
+	Entity   *string `binding:"omitempty,max=100" example:"healthCheck"`
+	EntityId *uint
+	From     *time.Time
+	To       *time.Time
+}
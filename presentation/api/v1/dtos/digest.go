@@ -0,0 +1,32 @@
+package dtos
+
+import (
+	"health-check/domain/enums"
+	"time"
+)
+
+type DigestCreateRequest struct {
+	NotificationChannelId uint               `binding:"required"`
+	Schedule              string             `binding:"required,max=100" example:"0 9 * * 1"`
+	Period                enums.DigestPeriod `binding:"required,enum"`
+	Tag                   *string            `binding:"omitempty,max=100"`
+	Labels                map[string]string  `binding:"omitempty,dive,keys,required,max=100,endkeys,required,max=200" example:"team:payments"`
+}
+
+type DigestCreateResponse struct {
+	Id                    uint
+	NotificationChannelId uint
+	Schedule              string
+	Period                enums.DigestPeriod
+	Tag                   *string
+	Labels                map[string]string
+	CreatedAt             time.Time
+}
+
+type DigestDeleteRequest struct {
+	Id uint `binding:"required"`
+}
+
+type DigestDeleteResponse struct {
+	Id uint
+}
@@ -0,0 +1,39 @@
+package dtos
+
+import (
+	"strconv"
+	"strings"
+)
+
+type IfMatchRequest struct {
+	ifMatch string
+}
+
+func (r *IfMatchRequest) SetIfMatch(ifMatch string) {
+	r.ifMatch = ifMatch
+}
+
+func (r *IfMatchRequest) Version() (*uint, error) {
+	ifMatch := strings.TrimSpace(r.ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+
+	version, err := strconv.ParseUint(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`), 10, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	result := uint(version)
+	return &result, nil
+}
+
+// ETagResponse carries the version of a health check to the ETag header, it is embedded with `json:"-"` so the
+// version is not repeated in the body.
+type ETagResponse struct {
+	Version uint
+}
+
+func (r *ETagResponse) ETag() string {
+	return strconv.Quote(strconv.FormatUint(uint64(r.Version), 10))
+}
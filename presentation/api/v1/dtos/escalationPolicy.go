@@ -0,0 +1,42 @@
+package dtos
+
+import (
+	"health-check/domain/valueObjects"
+	"time"
+)
+
+type EscalationStep struct {
+	NotificationChannelId uint `binding:"required"`
+	DelayMinute           uint `example:"10"`
+}
+
+type EscalationPolicyCreateRequest struct {
+	Name  string           `binding:"required" example:"payments"`
+	Steps []EscalationStep `binding:"required,min=1,dive"`
+}
+
+func (r EscalationPolicyCreateRequest) EscalationSteps() []valueObjects.EscalationStep {
+	steps := make([]valueObjects.EscalationStep, 0, len(r.Steps))
+	for _, step := range r.Steps {
+		steps = append(steps, valueObjects.EscalationStep{
+			NotificationChannelId: step.NotificationChannelId,
+			DelayMinute:           step.DelayMinute,
+		})
+	}
+	return steps
+}
+
+type EscalationPolicyCreateResponse struct {
+	Id        uint
+	Name      string
+	Steps     []valueObjects.EscalationStep
+	CreatedAt time.Time
+}
+
+type EscalationPolicyDeleteRequest struct {
+	Id uint `binding:"required"`
+}
+
+type EscalationPolicyDeleteResponse struct {
+	Id uint
+}
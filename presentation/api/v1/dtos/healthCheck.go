@@ -1,44 +1,212 @@
 package dtos
 
 import (
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+	"health-check/application/common"
 	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
 	"time"
 )
 
 type HealthCheckCreateRequest struct {
-	Interval string            `binding:"required" example:"1h30m10s"`
-	Url      string            `binding:"required,http_url" example:"https://google.com/"`
-	Method   enums.HttpMethod  `binding:"required,enum"`
-	Headers  map[string]string `binding:"required"`
-	Body     map[string]any    `binding:"required"`
+	IdempotencyKeyRequest
+	Name               string           `binding:"required,max=100" example:"google homepage"`
+	Description        string           `binding:"max=1000"`
+	RunbookUrl         string           `binding:"omitempty,http_url,max=600" example:"https://wiki.example.com/runbooks/google"`
+	Owner              string           `binding:"max=100" example:"team-search"`
+	Interval           string           `binding:"required" example:"1h30m10s"`
+	Url                string           `binding:"required_unless=Type heartbeat,max=600" example:"https://google.com/"`
+	Method             enums.HttpMethod `binding:"omitempty,enum"`
+	Headers            map[string]string
+	Body               map[string]any
+	Type               enums.ProbeType `binding:"omitempty,enum" example:"http"`
+	Settings           valueObjects.ProbeSettings
+	HeartbeatToken     *string
+	EscalationPolicyId *uint
+	Tags               []string          `binding:"dive,required,max=100"`
+	Labels             map[string]string `binding:"omitempty,dive,keys,required,max=100,endkeys,required,max=200" example:"team:payments"`
 }
 
 type HealthCheckCreateResponse struct {
-	Id        uint
-	Interval  string
-	Url       string
-	Method    enums.HttpMethod
-	Headers   map[string]string
-	Body      map[string]any
-	Status    enums.Status
-	CreatedAt time.Time
+	ETagResponse       `json:"-"`
+	Id                 uint
+	Name               string
+	Description        string
+	RunbookUrl         string
+	Owner              string
+	Interval           string
+	Url                string
+	Method             enums.HttpMethod
+	Headers            map[string]string
+	Body               map[string]any
+	Type               enums.ProbeType
+	Settings           valueObjects.ProbeSettings
+	HeartbeatToken     *string
+	Status             enums.Status
+	EscalationPolicyId *uint
+	Tags               []string
+	Labels             map[string]string
+	CreatedAt          time.Time
+}
+
+// HealthCheckResponse is a health check of the list, its header values and body strings are redacted down to their
+// template actions so the credentials written inline never leave the service.
+type HealthCheckResponse struct {
+	Id                 uint
+	Name               string
+	Description        string
+	RunbookUrl         string
+	Owner              string
+	Interval           string
+	Url                string
+	Method             enums.HttpMethod
+	Headers            map[string]string
+	Body               map[string]any
+	Type               enums.ProbeType
+	Settings           valueObjects.ProbeSettings
+	HeartbeatToken     *string
+	Status             enums.Status
+	EscalationPolicyId *uint
+	Flapping           bool
+	Tags               []string
+	Labels             map[string]string
+	Version            uint
+	Managed            bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type HealthCheckPaginateRequest struct {
+	common.PaginateQuery
+	Search string            `binding:"max=100" example:"google"`
+	Tags   []string          `binding:"dive,required,max=100"`
+	Labels map[string]string `binding:"omitempty,dive,keys,required,max=100,endkeys,required,max=200" example:"team:payments"`
+}
+
+type HealthCheckUpdateRequest struct {
+	IfMatchRequest
+	Id                 uint             `binding:"required"`
+	Name               string           `binding:"required,max=100" example:"google homepage"`
+	Description        string           `binding:"max=1000"`
+	RunbookUrl         string           `binding:"omitempty,http_url,max=600" example:"https://wiki.example.com/runbooks/google"`
+	Owner              string           `binding:"max=100" example:"team-search"`
+	Interval           string           `binding:"required" example:"1h30m10s"`
+	Url                string           `binding:"required_unless=Type heartbeat,max=600" example:"https://google.com/"`
+	Method             enums.HttpMethod `binding:"omitempty,enum"`
+	Headers            map[string]string
+	Body               map[string]any
+	Type               enums.ProbeType `binding:"omitempty,enum" example:"http"`
+	Settings           valueObjects.ProbeSettings
+	HeartbeatToken     *string
+	EscalationPolicyId *uint
+	Tags               []string          `binding:"dive,required,max=100"`
+	Labels             map[string]string `binding:"omitempty,dive,keys,required,max=100,endkeys,required,max=200" example:"team:payments"`
+}
+
+type HealthCheckUpdateResponse struct {
+	ETagResponse       `json:"-"`
+	Id                 uint
+	Name               string
+	Description        string
+	RunbookUrl         string
+	Owner              string
+	Interval           string
+	Url                string
+	Method             enums.HttpMethod
+	Headers            map[string]string
+	Body               map[string]any
+	Type               enums.ProbeType
+	Settings           valueObjects.ProbeSettings
+	HeartbeatToken     *string
+	Status             enums.Status
+	EscalationPolicyId *uint
+	Tags               []string
+	Labels             map[string]string
+	UpdatedAt          time.Time
 }
 
 type HealthCheckStatusRequest struct {
+	IfMatchRequest
 	Id     uint         `binding:"required"`
 	Status enums.Status `binding:"required,enum"`
 }
 
 type HealthCheckStatusResponse struct {
-	Id        uint
-	Status    enums.Status
-	UpdatedAt time.Time
+	ETagResponse `json:"-"`
+	Id           uint
+	Status       enums.Status
+	UpdatedAt    time.Time
 }
 
 type HealthCheckDeleteRequest struct {
+	IfMatchRequest
 	Id uint `binding:"required"`
 }
 
 type HealthCheckDeleteResponse struct {
 	Id uint
 }
+
+type HealthCheckDefinition struct {
+	Name             string                     `json:"name" yaml:"name" binding:"required,max=100" example:"google homepage"`
+	Description      string                     `json:"description,omitempty" yaml:"description,omitempty" binding:"max=1000"`
+	RunbookUrl       string                     `json:"runbookUrl,omitempty" yaml:"runbookUrl,omitempty" binding:"omitempty,http_url,max=600"`
+	Owner            string                     `json:"owner,omitempty" yaml:"owner,omitempty" binding:"max=100" example:"team-search"`
+	Interval         string                     `json:"interval" yaml:"interval" binding:"required" example:"1h30m10s"`
+	Url              string                     `json:"url" yaml:"url" binding:"required_unless=Type heartbeat,max=600" example:"https://google.com/"`
+	Method           enums.HttpMethod           `json:"method" yaml:"method" binding:"omitempty,enum"`
+	Headers          map[string]string          `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body             map[string]any             `json:"body,omitempty" yaml:"body,omitempty"`
+	Type             enums.ProbeType            `json:"type,omitempty" yaml:"type,omitempty" binding:"omitempty,enum"`
+	Settings         valueObjects.ProbeSettings `json:"settings" yaml:"settings,omitempty"`
+	EscalationPolicy string                     `json:"escalationPolicy,omitempty" yaml:"escalationPolicy,omitempty" binding:"max=100"`
+	Tags             []string                   `json:"tags,omitempty" yaml:"tags,omitempty" binding:"dive,required,max=100"`
+	Labels           map[string]string          `json:"labels,omitempty" yaml:"labels,omitempty" binding:"omitempty,dive,keys,required,max=100,endkeys,required,max=200"`
+}
+
+type HealthCheckImportRequest struct {
+	DryRun       bool                    `json:"dryRun,omitempty" yaml:"dryRun,omitempty"`
+	HealthChecks []HealthCheckDefinition `json:"healthChecks" yaml:"healthChecks" binding:"required,dive"`
+}
+
+type HealthCheckImportChange struct {
+	Id     uint
+	Owner  string
+	Name   string
+	Action enums.ImportAction
+}
+
+type HealthCheckImportResponse struct {
+	DryRun  bool
+	Changes []HealthCheckImportChange
+}
+
+type HealthCheckExportRequest struct {
+	Format enums.DocumentFormat `binding:"omitempty,enum" example:"yaml"`
+	Search string               `binding:"max=100" example:"google"`
+	Tags   []string             `binding:"dive,required,max=100"`
+	Labels map[string]string    `binding:"omitempty,dive,keys,required,max=100,endkeys,required,max=200" example:"team:payments"`
+}
+
+type HealthCheckExportResponse struct {
+	format       enums.DocumentFormat
+	HealthChecks []HealthCheckDefinition `json:"healthChecks" yaml:"healthChecks"`
+}
+
+func NewHealthCheckExportResponse(format enums.DocumentFormat, healthChecks []HealthCheckDefinition) *HealthCheckExportResponse {
+	return &HealthCheckExportResponse{
+		format:       format,
+		HealthChecks: healthChecks,
+	}
+}
+
+func (r *HealthCheckExportResponse) Raw() (string, []byte, error) {
+	if r.format == enums.DocumentFormatYaml {
+		data, err := yaml.Marshal(r)
+		return "application/x-yaml; charset=utf-8", data, err
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	return "application/json; charset=utf-8", data, err
+}
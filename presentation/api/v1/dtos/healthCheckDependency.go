@@ -0,0 +1,23 @@
+package dtos
+
+import "time"
+
+type HealthCheckDependencyCreateRequest struct {
+	HealthCheckId uint `binding:"required"`
+	ParentId      uint `binding:"required"`
+}
+
+type HealthCheckDependencyCreateResponse struct {
+	Id            uint
+	HealthCheckId uint
+	ParentId      uint
+	CreatedAt     time.Time
+}
+
+type HealthCheckDependencyDeleteRequest struct {
+	Id uint `binding:"required"`
+}
+
+type HealthCheckDependencyDeleteResponse struct {
+	Id uint
+}
@@ -0,0 +1,24 @@
+package dtos
+
+import "health-check/domain/enums"
+
+type HeartbeatPingRequest struct {
+	Token  string
+	Signal enums.HeartbeatSignal
+	Log    string
+}
+
+// SetRaw reads the token and the optional signal from the path, the body is kept as is as the log of the run.
+func (r *HeartbeatPingRequest) SetRaw(param func(key string) string, body []byte) {
+	r.Token = param("token")
+	r.Signal = enums.HeartbeatSignal(param("signal"))
+	if r.Signal == "" {
+		r.Signal = enums.HeartbeatSignalSuccess
+	}
+	r.Log = string(body)
+}
+
+type HeartbeatPingResponse struct {
+	Id     uint
+	Status string
+}
@@ -0,0 +1,13 @@
+package dtos
+
+type IdempotencyKeyRequest struct {
+	idempotencyKey string
+}
+
+func (r *IdempotencyKeyRequest) SetIdempotencyKey(idempotencyKey string) {
+	r.idempotencyKey = idempotencyKey
+}
+
+func (r *IdempotencyKeyRequest) IdempotencyKey() string {
+	return r.idempotencyKey
+}
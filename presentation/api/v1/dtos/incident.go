@@ -0,0 +1,18 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+	"time"
+)
+
+type IncidentAcknowledgeRequest struct {
+	Id uint `binding:"required"`
+}
+
+type IncidentAcknowledgeResponse struct {
+	Id             uint
+	Status         enums.IncidentStatus
+	AcknowledgedAt *time.Time
+	AcknowledgedBy *uuid.UUID
+}
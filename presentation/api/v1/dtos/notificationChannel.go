@@ -0,0 +1,30 @@
+package dtos
+
+import (
+	"health-check/domain/enums"
+	"time"
+)
+
+type NotificationChannelCreateRequest struct {
+	Name     string                     `binding:"required" example:"payments-oncall"`
+	Provider enums.NotificationProvider `binding:"required,enum"`
+	Receiver string                     `binding:"required" example:"1204915943634108527"`
+	Labels   map[string]string          `binding:"omitempty,dive,keys,required,max=100,endkeys,required,max=200" example:"team:payments"`
+}
+
+type NotificationChannelCreateResponse struct {
+	Id        uint
+	Name      string
+	Provider  enums.NotificationProvider
+	Receiver  string
+	Labels    map[string]string
+	CreatedAt time.Time
+}
+
+type NotificationChannelDeleteRequest struct {
+	Id uint `binding:"required"`
+}
+
+type NotificationChannelDeleteResponse struct {
+	Id uint
+}
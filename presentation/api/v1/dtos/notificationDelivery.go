@@ -0,0 +1,23 @@
+package dtos
+
+import (
+	"health-check/application/common"
+	"health-check/domain/enums"
+	"time"
+)
+
+type NotificationDeliveryPaginateRequest struct {
+	common.PaginateQuery
+	// Statuses defaults to the failed deliveries still retrying and the dead-lettered ones
+	Statuses []enums.DeliveryStatus `binding:"omitempty,dive,enum" example:"dead"`
+}
+
+type NotificationDeliveryRetryRequest struct {
+	Id uint `json:"-" uri:"id" binding:"required"`
+}
+
+type NotificationDeliveryRetryResponse struct {
+	Id            uint
+	Status        enums.DeliveryStatus
+	NextAttemptAt time.Time
+}
@@ -0,0 +1,27 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+	"health-check/domain/enums"
+	"time"
+)
+
+type RoleBindingCreateRequest struct {
+	UserId uuid.UUID  `binding:"required" example:"e4da08e1-96d4-4558-9fb9-c7e04bb37e02"`
+	Role   enums.Role `binding:"required,enum"`
+}
+
+type RoleBindingCreateResponse struct {
+	Id        uint
+	UserId    uuid.UUID
+	Role      enums.Role
+	CreatedAt time.Time
+}
+
+type RoleBindingDeleteRequest struct {
+	Id uint `binding:"required"`
+}
+
+type RoleBindingDeleteResponse struct {
+	Id uint
+}
@@ -0,0 +1,37 @@
+package dtos
+
+import "time"
+
+type SecretCreateRequest struct {
+	Name        string `binding:"required,max=100" example:"payments-api-token"`
+	Description string `binding:"max=1000"`
+	Value       string `binding:"required"`
+}
+
+type SecretCreateResponse struct {
+	Id          uint
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+type SecretUpdateRequest struct {
+	Id          uint   `binding:"required"`
+	Description string `binding:"max=1000"`
+	Value       *string
+}
+
+type SecretUpdateResponse struct {
+	Id          uint
+	Name        string
+	Description string
+	UpdatedAt   time.Time
+}
+
+type SecretDeleteRequest struct {
+	Id uint `binding:"required"`
+}
+
+type SecretDeleteResponse struct {
+	Id uint
+}
@@ -0,0 +1,34 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SilenceCreateRequest struct {
+	HealthCheckId  *uint
+	Tag            *string           `binding:"omitempty,max=100"`
+	Labels         map[string]string `binding:"omitempty,dive,keys,required,max=100,endkeys,required,max=200" example:"team:payments"`
+	Reason         string            `binding:"required,max=600"`
+	DurationMinute uint              `binding:"required,min=1" example:"60"`
+}
+
+type SilenceCreateResponse struct {
+	Id            uint
+	HealthCheckId *uint
+	Tag           *string
+	Labels        map[string]string
+	Reason        string
+	CreatedBy     uuid.UUID
+	StartsAt      time.Time
+	EndsAt        time.Time
+}
+
+type SilenceExpireRequest struct {
+	Id uint `binding:"required"`
+}
+
+type SilenceExpireResponse struct {
+	Id     uint
+	EndsAt time.Time
+}
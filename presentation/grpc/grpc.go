@@ -2,33 +2,49 @@ package grpc
 
 import (
 	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-jwt"
 	"github.com/ehsandavari/go-logger"
 	grpcPrometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"google.golang.org/grpc"
+	healthProto "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"health-check/application"
 	"health-check/infrastructure/config"
 	"health-check/pkg/tracer"
-	//"healthCheck/presentation/grpc/proto/aaa"
-	//"healthCheck/presentation/grpc/services"
+	healthCheckProto "health-check/presentation/grpc/healthCheckProto"
+	"health-check/presentation/grpc/services"
 	"log"
 	"net"
+	"time"
 )
 
 type SGrpc struct {
-	server  *grpc.Server
-	sConfig *config.SConfig
-	iLogger logger.ILogger
-	iTracer tracer.ITracer
+	server        *grpc.Server
+	healthService *services.HealthService
+	done          chan struct{}
+	application   *application.Application
+	sConfig       *config.SConfig
+	iJwtServer    jwt.IJwtServer
+	iLogger       logger.ILogger
+	iTracer       tracer.ITracer
 }
 
-func NewSGrpc(application *application.Application, sConfig *config.SConfig, iLogger logger.ILogger, iTracer tracer.ITracer) *SGrpc {
+func NewSGrpc(application *application.Application, sConfig *config.SConfig, iJwtServer jwt.IJwtServer, iLogger logger.ILogger, iTracer tracer.ITracer) *SGrpc {
 	var sGrpc SGrpc
 	sGrpc.sConfig = sConfig
 	if *sConfig.Service.Grpc.IsEnabled {
-		sGrpc.server = grpc.NewServer()
+		sGrpc.application = application
+		sGrpc.iJwtServer = iJwtServer
 		sGrpc.iLogger = iLogger
 		sGrpc.iTracer = iTracer
+		sGrpc.server = grpc.NewServer(
+			grpc.ChainUnaryInterceptor(grpcPrometheus.UnaryServerInterceptor, sGrpc.authorize),
+			grpc.StreamInterceptor(sGrpc.authorizeStream),
+		)
+		sGrpc.healthService = services.NewHealthService(application.Readiness)
+		sGrpc.done = make(chan struct{})
+		healthCheckProto.RegisterHealthCheckServiceServer(sGrpc.server, services.NewHealthCheckService(application, iLogger, iTracer))
+		healthProto.RegisterHealthServer(sGrpc.server, sGrpc.healthService)
 	}
 	return &sGrpc
 }
@@ -39,7 +55,6 @@ func (r *SGrpc) Start() {
 		if err != nil {
 			log.Fatal("error in net listen ", err)
 		}
-		//aaa.RegisterAuthServiceServer(r.server, services.NewAuthService())
 		grpcPrometheus.Register(r.server)
 
 		if *r.sConfig.Service.Grpc.IsDevelopment {
@@ -48,6 +63,9 @@ func (r *SGrpc) Start() {
 
 		ctx := contextplus.Background()
 
+		r.healthService.Refresh(ctx)
+		go r.refreshHealth(ctx)
+
 		go func() {
 			if err = r.server.Serve(netListener); err != nil {
 				r.iLogger.WithError(err).Fatal(ctx, "error in serve grpc server")
@@ -57,8 +75,24 @@ func (r *SGrpc) Start() {
 	}
 }
 
+func (r *SGrpc) refreshHealth(ctx *contextplus.Context) {
+	ticker := time.NewTicker(time.Duration(r.sConfig.Readiness.RefreshIntervalSecond) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			r.healthService.Refresh(ctx)
+		}
+	}
+}
+
 func (r *SGrpc) Stop() {
 	if *r.sConfig.Service.Grpc.IsEnabled {
+		close(r.done)
+		r.healthService.Shutdown()
 		r.server.GracefulStop()
 	}
 }
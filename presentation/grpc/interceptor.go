@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthProto "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionProto "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionAlphaProto "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/pkg/jwtParser"
+	healthCheckProto "health-check/presentation/grpc/healthCheckProto"
+	"strings"
+)
+
+const (
+	_apiKeyMetadata        = "x-api-key"
+	_authorizationMetadata = "authorization"
+	_requestIdMetadata     = "x-request-id"
+	_tenantIdMetadata      = "x-tenant-id"
+)
+
+// anonymous lists the methods served without a caller, the health and reflection services.
+var anonymous = map[string]bool{
+	healthProto.Health_Check_FullMethodName:                                   true,
+	healthProto.Health_Watch_FullMethodName:                                   true,
+	reflectionProto.ServerReflection_ServerReflectionInfo_FullMethodName:      true,
+	reflectionAlphaProto.ServerReflection_ServerReflectionInfo_FullMethodName: true,
+}
+
+// permissions lists the permission every other method needs, a method missing here and from anonymous is denied so a
+// method added to a service stays closed until it is listed.
+var permissions = map[string]enums.Permission{
+	healthCheckProto.HealthCheckService_List_FullMethodName:   enums.PermissionList,
+	healthCheckProto.HealthCheckService_Get_FullMethodName:    enums.PermissionList,
+	healthCheckProto.HealthCheckService_Create_FullMethodName: enums.PermissionCreate,
+	healthCheckProto.HealthCheckService_Update_FullMethodName: enums.PermissionUpdate,
+	healthCheckProto.HealthCheckService_Status_FullMethodName: enums.PermissionStatus,
+	healthCheckProto.HealthCheckService_Delete_FullMethodName: enums.PermissionDelete,
+}
+
+func (r *SGrpc) authorize(reqCtx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if anonymous[info.FullMethod] {
+		return handler(reqCtx, request)
+	}
+
+	permission, ok := permissions[info.FullMethod]
+	if !ok {
+		r.iLogger.WithString("method", info.FullMethod).Warn(contextplus.NewContext(reqCtx), "grpc method has no permission")
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	ctx := contextplus.NewContext(reqCtx)
+	md, _ := metadata.FromIncomingContext(reqCtx)
+	if requestId := md.Get(_requestIdMetadata); len(requestId) != 0 {
+		ctx.SetRequestId(requestId[0])
+	} else {
+		ctx.SetRequestId(uuid.NewString())
+	}
+
+	var apiKey *entities.ApiKey
+	if key := md.Get(_apiKeyMetadata); len(key) != 0 {
+		var err error
+		if apiKey, err = r.application.Commands.ApiKeyAuthenticate.Handle(ctx, commands.NewApiKeyAuthenticateCommand(key[0])); err != nil {
+			r.iLogger.WithError(err).Warn(ctx, "api key is invalid")
+			return nil, status.Error(codes.Unauthenticated, "api key is invalid")
+		}
+		ctx.User.SetId(apiKey.TenantId)
+	} else if err := r.verifyToken(ctx, md.Get(_authorizationMetadata)); err != nil {
+		return nil, err
+	}
+
+	tenantId := ctx.User.Id()
+	if value := md.Get(_tenantIdMetadata); len(value) != 0 {
+		var err error
+		if tenantId, err = uuid.Parse(value[0]); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "tenant id is invalid")
+		}
+	}
+
+	role, err := r.application.Queries.UserRole.Handle(ctx, queries.NewUserRoleQuery(tenantId, ctx.User.Id()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if apiKey != nil && !apiKey.Allows(permission) {
+		r.iLogger.WithAny("permission", permission).Warn(ctx, "permission denied for api key")
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	if !role.Allows(permission) {
+		r.iLogger.WithAny("tenantId", tenantId).WithAny("role", role).WithAny("permission", permission).Warn(ctx, "permission denied")
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	common.SetTenantId(ctx, tenantId)
+	return handler(ctx.ToContext(), request)
+}
+
+// authorizeStream serves the anonymous streams and denies every other one, no stream of the services needs a caller.
+func (r *SGrpc) authorizeStream(server any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if anonymous[info.FullMethod] {
+		return handler(server, stream)
+	}
+
+	r.iLogger.WithString("method", info.FullMethod).Warn(contextplus.NewContext(stream.Context()), "grpc stream has no permission")
+	return status.Error(codes.PermissionDenied, "permission denied")
+}
+
+func (r *SGrpc) verifyToken(ctx *contextplus.Context, authorization []string) error {
+	if len(authorization) == 0 {
+		r.iLogger.Warn(ctx, "authorization not set in request metadata")
+		return status.Error(codes.Unauthenticated, "authorization not set")
+	}
+
+	token := strings.TrimPrefix(authorization[0], "Bearer ")
+	if len(token) == 0 || token == authorization[0] {
+		r.iLogger.Warn(ctx, "authorization token is invalid value")
+		return status.Error(codes.Unauthenticated, "authorization token is invalid")
+	}
+
+	claims, err := jwtParser.Parse(token, r.sConfig.Jwt.Algorithm, r.sConfig.Jwt.PublicKey)
+	if err != nil {
+		r.iLogger.WithError(err).Warn(ctx, "authorization token is invalid")
+		return status.Error(codes.Unauthenticated, "authorization token is invalid")
+	}
+
+	userId, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		r.iLogger.WithError(err).Warn(ctx, "authorization token subject is not a uuid")
+		return status.Error(codes.Unauthenticated, "authorization token is invalid")
+	}
+
+	ctx.User.SetId(userId)
+	return nil
+}
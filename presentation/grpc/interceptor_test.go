@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"github.com/ehsandavari/go-logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthProto "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionProto "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+type sServerStream struct {
+	grpc.ServerStream
+}
+
+func (r sServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestAuthorizeAnonymous(t *testing.T) {
+	tableTests := []struct {
+		name   string
+		method string
+		stream bool
+		served bool
+	}{
+		{
+			name:   "health check",
+			method: healthProto.Health_Check_FullMethodName,
+			served: true,
+		},
+		{
+			name:   "health watch",
+			method: healthProto.Health_Watch_FullMethodName,
+			stream: true,
+			served: true,
+		},
+		{
+			name:   "reflection",
+			method: reflectionProto.ServerReflection_ServerReflectionInfo_FullMethodName,
+			stream: true,
+			served: true,
+		},
+		{
+			name:   "method without a permission",
+			method: "/healthCheck.HealthCheckService/Export",
+		},
+		{
+			name:   "stream without a permission",
+			method: "/healthCheck.HealthCheckService/Watch",
+			stream: true,
+		},
+	}
+
+	for _, tableTest := range tableTests {
+		t.Run(tableTest.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			iLogger := logger.NewMockILogger(mockController)
+			sGrpc := &SGrpc{iLogger: iLogger}
+
+			if !tableTest.served {
+				iLogger.EXPECT().WithString("method", tableTest.method).Return(iLogger).Times(1)
+				iLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+			}
+
+			served := false
+			var err error
+			if tableTest.stream {
+				err = sGrpc.authorizeStream(nil, sServerStream{}, &grpc.StreamServerInfo{FullMethod: tableTest.method}, func(any, grpc.ServerStream) error {
+					served = true
+					return nil
+				})
+			} else {
+				_, err = sGrpc.authorize(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: tableTest.method}, func(context.Context, any) (any, error) {
+					served = true
+					return nil, nil
+				})
+			}
+
+			assert.Equal(t, tableTest.served, served)
+			if tableTest.served {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"health-check/application/common"
+)
+
+var statusCodes = map[uint]codes.Code{
+	common.ErrorBadRequest.Code(): codes.InvalidArgument,
+	common.ErrorNotFound.Code():   codes.NotFound,
+	common.ErrorForbidden.Code():  codes.PermissionDenied,
+	common.ErrorConflict.Code():   codes.Aborted,
+}
+
+func statusError(err error) error {
+	iError, ok := err.(common.IError)
+	if !ok {
+		return status.Error(codes.Internal, common.ErrorInternalServer.Error())
+	}
+
+	if code, ok := statusCodes[iError.Code()]; ok {
+		return status.Error(code, iError.Error())
+	}
+	return status.Error(codes.Internal, iError.Error())
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"github.com/ehsandavari/go-context-plus"
+	"google.golang.org/grpc/health"
+	healthProto "google.golang.org/grpc/health/grpc_health_v1"
+	"health-check/application"
+	"health-check/domain/enums"
+	healthCheckProto "health-check/presentation/grpc/healthCheckProto"
+)
+
+// HealthService serves grpc.health.v1 from the application readiness, the empty service name and the
+// health check service report the overall status and every readiness component is a service of its own.
+type HealthService struct {
+	*health.Server
+	readiness *application.SReadiness
+}
+
+func NewHealthService(readiness *application.SReadiness) *HealthService {
+	return &HealthService{
+		Server:    health.NewServer(),
+		readiness: readiness,
+	}
+}
+
+func (r *HealthService) Refresh(ctx *contextplus.Context) {
+	readiness := r.readiness.Check(ctx)
+
+	r.SetServingStatus("", servingStatus(readiness.IsReady()))
+	r.SetServingStatus(healthCheckProto.HealthCheckService_ServiceDesc.ServiceName, servingStatus(readiness.IsReady()))
+	for name, component := range readiness.Components {
+		r.SetServingStatus(name, servingStatus(component.Status == enums.ReadinessStatusUp))
+	}
+}
+
+func servingStatus(ready bool) healthProto.HealthCheckResponse_ServingStatus {
+	if ready {
+		return healthProto.HealthCheckResponse_SERVING
+	}
+	return healthProto.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/tracer"
+	healthCheckProto "health-check/presentation/grpc/healthCheckProto"
+)
+
+type HealthCheckService struct {
+	application *application.Application
+	iLogger     logger.ILogger
+	iTracer     tracer.ITracer
+}
+
+func NewHealthCheckService(application *application.Application, iLogger logger.ILogger, iTracer tracer.ITracer) *HealthCheckService {
+	return &HealthCheckService{
+		application: application,
+		iLogger:     iLogger,
+		iTracer:     iTracer,
+	}
+}
+
+func (r *HealthCheckService) List(reqCtx context.Context, request *healthCheckProto.ListRequest) (*healthCheckProto.ListResponse, error) {
+	span, ctx := r.iTracer.SpanFromContext(contextplus.FromContext(reqCtx))
+	defer span.Finish()
+
+	paginateQuery := common.PaginateQuery{Page: uint(request.GetPage()), PerPage: uint(request.GetPerPage()), OrderBy: request.GetOrderBy()}
+	if paginateQuery.Page == 0 {
+		paginateQuery.Page = 1
+	}
+	if paginateQuery.PerPage == 0 {
+		paginateQuery.PerPage = 10
+	}
+
+	healthChecks, err := r.application.Queries.HealthCheckPaginate.Handle(ctx, queries.NewHealthCheckPaginateQuery(
+		common.TenantId(ctx), paginateQuery, request.GetSearch(), request.GetTags(), request.GetLabels(),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("request", request).Error(ctx, "error in send mediator paginate health check")
+
+		return nil, statusError(err)
+	}
+
+	response := &healthCheckProto.ListResponse{
+		Page:       uint32(healthChecks.Page),
+		PerPage:    uint32(healthChecks.PerPage),
+		TotalPage:  uint32(healthChecks.TotalPage),
+		TotalItems: healthChecks.TotalItems,
+		Items:      make([]*healthCheckProto.HealthCheck, 0, len(healthChecks.Items)),
+	}
+	for i := range healthChecks.Items {
+		item, err := toHealthCheck(&healthChecks.Items[i])
+		if err != nil {
+			r.iLogger.WithError(err).WithAny("request", request).Error(ctx, "error in convert health check")
+
+			return nil, statusError(common.ErrorInternalServer)
+		}
+		response.Items = append(response.Items, item)
+	}
+
+	return response, nil
+}
+
+func (r *HealthCheckService) Get(reqCtx context.Context, request *healthCheckProto.GetRequest) (*healthCheckProto.HealthCheck, error) {
+	span, ctx := r.iTracer.SpanFromContext(contextplus.FromContext(reqCtx))
+	defer span.Finish()
+
+	if request.GetId() == 0 {
+		return nil, statusError(common.ErrorBadRequest)
+	}
+
+	healthCheck, err := r.application.Queries.HealthCheckGet.Handle(ctx, queries.NewHealthCheckGetQuery(common.TenantId(ctx), uint(request.GetId())))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("request", request).Error(ctx, "error in send mediator get health check")
+
+		return nil, statusError(err)
+	}
+
+	return r.response(ctx, healthCheck)
+}
+
+func (r *HealthCheckService) Create(reqCtx context.Context, request *healthCheckProto.CreateRequest) (*healthCheckProto.HealthCheck, error) {
+	span, ctx := r.iTracer.SpanFromContext(contextplus.FromContext(reqCtx))
+	defer span.Finish()
+
+	settings, err := probeSettings(request.GetSettings())
+	if err != nil || !validDefinition(request.GetName(), request.GetInterval(), request.GetUrl(), request.GetMethod(), request.GetType(), settings) {
+		return nil, statusError(common.ErrorBadRequest)
+	}
+
+	healthCheck, err := r.application.Commands.HealthCheckCreate.Handle(ctx, commands.NewHealthCheckCreateCommand(
+		common.TenantId(ctx), request.GetIdempotencyKey(), request.GetName(), request.GetDescription(), request.GetRunbookUrl(), request.GetOwner(), request.GetInterval(), request.GetUrl(), enums.HttpMethod(request.GetMethod()), headers(request.GetHeaders()), request.GetBody().AsMap(), enums.ProbeType(request.GetType()), settings, optionalUint(request.EscalationPolicyId), request.GetTags(), request.GetLabels(),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("request", request).Error(ctx, "error in send mediator create health check")
+
+		return nil, statusError(err)
+	}
+
+	return r.response(ctx, healthCheck)
+}
+
+func (r *HealthCheckService) Update(reqCtx context.Context, request *healthCheckProto.UpdateRequest) (*healthCheckProto.HealthCheck, error) {
+	span, ctx := r.iTracer.SpanFromContext(contextplus.FromContext(reqCtx))
+	defer span.Finish()
+
+	settings, err := probeSettings(request.GetSettings())
+	if err != nil || request.GetId() == 0 || !validDefinition(request.GetName(), request.GetInterval(), request.GetUrl(), request.GetMethod(), request.GetType(), settings) {
+		return nil, statusError(common.ErrorBadRequest)
+	}
+
+	healthCheck, err := r.application.Commands.HealthCheckUpdate.Handle(ctx, commands.NewHealthCheckUpdateCommand(
+		common.TenantId(ctx), uint(request.GetId()), optionalUint(request.Version), request.GetName(), request.GetDescription(), request.GetRunbookUrl(), request.GetOwner(), request.GetInterval(), request.GetUrl(), enums.HttpMethod(request.GetMethod()), headers(request.GetHeaders()), request.GetBody().AsMap(), enums.ProbeType(request.GetType()), settings, optionalUint(request.EscalationPolicyId), request.GetTags(), request.GetLabels(),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("request", request).Error(ctx, "error in send mediator update health check")
+
+		return nil, statusError(err)
+	}
+
+	return r.response(ctx, healthCheck)
+}
+
+func (r *HealthCheckService) Status(reqCtx context.Context, request *healthCheckProto.StatusRequest) (*healthCheckProto.HealthCheck, error) {
+	span, ctx := r.iTracer.SpanFromContext(contextplus.FromContext(reqCtx))
+	defer span.Finish()
+
+	if request.GetId() == 0 || !enums.Status(request.GetStatus()).IsValid() {
+		return nil, statusError(common.ErrorBadRequest)
+	}
+
+	healthCheck, err := r.application.Commands.HealthCheckStatus.Handle(ctx, commands.NewHealthCheckStatusCommand(
+		common.TenantId(ctx), uint(request.GetId()), optionalUint(request.Version), enums.Status(request.GetStatus()),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("request", request).Error(ctx, "error in send mediator status health check")
+
+		return nil, statusError(err)
+	}
+
+	return r.response(ctx, healthCheck)
+}
+
+func (r *HealthCheckService) Delete(reqCtx context.Context, request *healthCheckProto.DeleteRequest) (*healthCheckProto.DeleteResponse, error) {
+	span, ctx := r.iTracer.SpanFromContext(contextplus.FromContext(reqCtx))
+	defer span.Finish()
+
+	if request.GetId() == 0 {
+		return nil, statusError(common.ErrorBadRequest)
+	}
+
+	healthCheck, err := r.application.Commands.HealthCheckDelete.Handle(ctx, commands.NewHealthCheckDeleteCommand(
+		common.TenantId(ctx), uint(request.GetId()), optionalUint(request.Version),
+	))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("err", err)
+		r.iLogger.WithError(err).WithAny("request", request).Error(ctx, "error in send mediator delete health check")
+
+		return nil, statusError(err)
+	}
+
+	return &healthCheckProto.DeleteResponse{Id: uint64(healthCheck.Id)}, nil
+}
+
+func (r *HealthCheckService) response(ctx *contextplus.Context, healthCheck *entities.HealthCheck) (*healthCheckProto.HealthCheck, error) {
+	response, err := toHealthCheck(healthCheck)
+	if err != nil {
+		r.iLogger.WithError(err).WithAny("healthCheck", healthCheck).Error(ctx, "error in convert health check")
+
+		return nil, statusError(common.ErrorInternalServer)
+	}
+	return response, nil
+}
+
+func toHealthCheck(healthCheck *entities.HealthCheck) (*healthCheckProto.HealthCheck, error) {
+	body, err := structpb.NewStruct(valueObjects.RedactBody(healthCheck.Body.Data()))
+	if err != nil {
+		return nil, err
+	}
+	settings, err := toStruct(healthCheck.Settings.Data())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &healthCheckProto.HealthCheck{
+		Id:             uint64(healthCheck.Id),
+		Name:           healthCheck.Name,
+		Description:    healthCheck.Description,
+		RunbookUrl:     healthCheck.RunbookUrl,
+		Owner:          healthCheck.Owner,
+		Interval:       healthCheck.Interval,
+		Url:            healthCheck.Url,
+		Method:         healthCheck.Method.String(),
+		Headers:        valueObjects.RedactHeaders(healthCheck.Headers.Data()),
+		Body:           body,
+		Status:         healthCheck.Status.String(),
+		Tags:           healthCheck.TagNames(),
+		Labels:         healthCheck.LabelMap(),
+		Version:        uint64(healthCheck.Version),
+		Managed:        healthCheck.Managed,
+		CreatedAt:      timestamppb.New(healthCheck.CreatedAt),
+		UpdatedAt:      timestamppb.New(healthCheck.UpdatedAt),
+		Type:           healthCheck.Type.String(),
+		Settings:       settings,
+		HeartbeatToken: healthCheck.HeartbeatToken,
+	}
+	if healthCheck.EscalationPolicyId != nil {
+		escalationPolicyId := uint64(*healthCheck.EscalationPolicyId)
+		response.EscalationPolicyId = &escalationPolicyId
+	}
+	return response, nil
+}
+
+// validDefinition mirrors the required fields the rest api enforces through its binding tags.
+func validDefinition(name string, interval string, url string, method string, probeType string, settings valueObjects.ProbeSettings) bool {
+	if len(probeType) == 0 {
+		probeType = enums.ProbeTypeHttp.String()
+	}
+	return len(name) != 0 && len(name) <= 100 && len(interval) != 0 && settings.IsValid(enums.ProbeType(probeType), url, enums.HttpMethod(method))
+}
+
+// probeSettings reads the settings struct through its json form, the same shape the rest api accepts.
+func probeSettings(settings *structpb.Struct) (valueObjects.ProbeSettings, error) {
+	var result valueObjects.ProbeSettings
+	if settings == nil {
+		return result, nil
+	}
+	data, err := settings.MarshalJSON()
+	if err != nil {
+		return result, err
+	}
+	return result, json.Unmarshal(data, &result)
+}
+
+func toStruct(settings valueObjects.ProbeSettings) (*structpb.Struct, error) {
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	result := &structpb.Struct{}
+	return result, result.UnmarshalJSON(data)
+}
+
+func optionalUint(value *uint64) *uint {
+	if value == nil {
+		return nil
+	}
+	result := uint(*value)
+	return &result
+}
+
+func headers(headers map[string]string) map[string]string {
+	if headers == nil {
+		return map[string]string{}
+	}
+	return headers
+}
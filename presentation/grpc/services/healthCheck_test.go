@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"github.com/ehsandavari/go-context-plus"
+	"github.com/ehsandavari/go-logger"
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/datatypes"
+	"health-check/application"
+	"health-check/application/common"
+	"health-check/application/handlers/commands"
+	"health-check/application/handlers/queries"
+	"health-check/domain/entities"
+	"health-check/domain/enums"
+	"health-check/domain/valueObjects"
+	"health-check/pkg/tracer"
+	healthCheckProto "health-check/presentation/grpc/healthCheckProto"
+	"net"
+	"testing"
+)
+
+type handlerFunc[T1 any, T2 any] func(ctx *contextplus.Context, command T1) (T2, error)
+
+func (r handlerFunc[T1, T2]) Handle(ctx *contextplus.Context, command T1) (T2, error) {
+	return r(ctx, command)
+}
+
+func setup(t *testing.T, application *application.Application) healthCheckProto.HealthCheckServiceClient {
+	mockController := gomock.NewController(t)
+	iLogger := logger.NewMockILogger(mockController)
+	iTracer := tracer.NewMockITracer(mockController)
+	iSpan := tracer.NewMockISpan(mockController)
+
+	iTracer.EXPECT().SpanFromContext(gomock.Any()).DoAndReturn(func(ctx *contextplus.Context, _ ...opentracing.StartSpanOption) (tracer.ISpan, *contextplus.Context) {
+		return iSpan, ctx
+	}).AnyTimes()
+	iSpan.EXPECT().Finish().AnyTimes()
+	iSpan.EXPECT().SetTag(gomock.Any(), gomock.Any()).Return(iSpan).AnyTimes()
+	iSpan.EXPECT().LogKV(gomock.Any()).AnyTimes()
+	iLogger.EXPECT().WithError(gomock.Any()).Return(iLogger).AnyTimes()
+	iLogger.EXPECT().WithAny(gomock.Any(), gomock.Any()).Return(iLogger).AnyTimes()
+	iLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	healthCheckProto.RegisterHealthCheckServiceServer(server, NewHealthCheckService(application, iLogger, iTracer))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+		mockController.Finish()
+	})
+	return healthCheckProto.NewHealthCheckServiceClient(conn)
+}
+
+func healthCheck() *entities.HealthCheck {
+	healthCheck := entities.NewHealthCheck(
+		[16]byte{}, "google", "", "", "team-search", "1m", "https://google.com/", enums.HttpMethodGET,
+		map[string]string{"Accept": "text/html", "Authorization": `Bearer {{ secret "token" }}`}, map[string]any{"query": "health"}, enums.ProbeTypeHttp, valueObjects.ProbeSettings{}, enums.StatusStart, nil,
+	)
+	healthCheck.Id = 7
+	healthCheck.Tags = []entities.Tag{{Name: "search"}}
+	return &healthCheck
+}
+
+func TestHealthCheckService_Create(t *testing.T) {
+	var received commands.SHealthCheckCreateCommand
+	client := setup(t, &application.Application{
+		Commands: commands.Commands{
+			HealthCheckCreate: handlerFunc[commands.SHealthCheckCreateCommand, *entities.HealthCheck](func(ctx *contextplus.Context, command commands.SHealthCheckCreateCommand) (*entities.HealthCheck, error) {
+				received = command
+				return healthCheck(), nil
+			}),
+		},
+	})
+
+	body, err := structpb.NewStruct(map[string]any{"query": "health"})
+	assert.NoError(t, err)
+
+	response, err := client.Create(context.Background(), &healthCheckProto.CreateRequest{
+		Name:     "google",
+		Owner:    "team-search",
+		Interval: "1m",
+		Url:      "https://google.com/",
+		Method:   "GET",
+		Headers:  map[string]string{"Accept": "text/html"},
+		Body:     body,
+		Tags:     []string{"search"},
+	})
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, commands.SHealthCheckCreateCommand{}, received)
+	assert.Equal(t, uint64(7), response.GetId())
+	assert.Equal(t, "google", response.GetName())
+	assert.Equal(t, uint64(1), response.GetVersion())
+	assert.Equal(t, []string{"search"}, response.GetTags())
+	assert.Equal(t, map[string]any{"query": "***"}, response.GetBody().AsMap())
+	assert.Nil(t, response.EscalationPolicyId)
+}
+
+func TestHealthCheckService_Create_InvalidArgument(t *testing.T) {
+	client := setup(t, &application.Application{})
+
+	_, err := client.Create(context.Background(), &healthCheckProto.CreateRequest{Interval: "1m", Url: "https://google.com/", Method: "GET"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestHealthCheckService_List(t *testing.T) {
+	client := setup(t, &application.Application{
+		Queries: queries.Queries{
+			HealthCheckPaginate: handlerFunc[queries.SHealthCheckPaginateQuery, *common.PaginateResult[entities.HealthCheck]](func(ctx *contextplus.Context, query queries.SHealthCheckPaginateQuery) (*common.PaginateResult[entities.HealthCheck], error) {
+				return common.NewPaginateResult([]entities.HealthCheck{*healthCheck()}, 1, 10, 1), nil
+			}),
+		},
+	})
+
+	response, err := client.List(context.Background(), &healthCheckProto.ListRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), response.GetPage())
+	assert.Equal(t, uint32(10), response.GetPerPage())
+	assert.Equal(t, uint64(1), response.GetTotalItems())
+	assert.Len(t, response.GetItems(), 1)
+	assert.Equal(t, map[string]string{"Accept": "***", "Authorization": `*** {{ secret "token" }}`}, response.GetItems()[0].GetHeaders())
+}
+
+func TestHealthCheckService_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "not found", err: common.ErrorNotFound, code: codes.NotFound},
+		{name: "conflict", err: common.ErrorConflict, code: codes.Aborted},
+		{name: "forbidden", err: common.ErrorForbidden, code: codes.PermissionDenied},
+		{name: "bad request", err: common.ErrorBadRequest, code: codes.InvalidArgument},
+		{name: "internal", err: common.ErrorInternalServer, code: codes.Internal},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := setup(t, &application.Application{
+				Queries: queries.Queries{
+					HealthCheckGet: handlerFunc[queries.SHealthCheckGetQuery, *entities.HealthCheck](func(ctx *contextplus.Context, query queries.SHealthCheckGetQuery) (*entities.HealthCheck, error) {
+						return nil, test.err
+					}),
+				},
+			})
+
+			_, err := client.Get(context.Background(), &healthCheckProto.GetRequest{Id: 7})
+
+			assert.Equal(t, test.code, status.Code(err))
+		})
+	}
+}
+
+func TestHealthCheckService_Status(t *testing.T) {
+	var received commands.SHealthCheckStatusCommand
+	client := setup(t, &application.Application{
+		Commands: commands.Commands{
+			HealthCheckStatus: handlerFunc[commands.SHealthCheckStatusCommand, *entities.HealthCheck](func(ctx *contextplus.Context, command commands.SHealthCheckStatusCommand) (*entities.HealthCheck, error) {
+				received = command
+				stopped := healthCheck()
+				stopped.SetStatus(enums.StatusStop)
+				stopped.Body = datatypes.NewJSONType(map[string]any{})
+				return stopped, nil
+			}),
+		},
+	})
+	version := uint64(1)
+
+	response, err := client.Status(context.Background(), &healthCheckProto.StatusRequest{Id: 7, Version: &version, Status: "stop"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, commands.NewHealthCheckStatusCommand([16]byte{}, 7, func() *uint { v := uint(1); return &v }(), enums.StatusStop), received)
+	assert.Equal(t, "stop", response.GetStatus())
+}
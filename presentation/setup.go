@@ -8,14 +8,16 @@ import (
 )
 
 type Presentation struct {
-	sApi  *api.SApi
-	sGrpc *grpc.SGrpc
+	application *application.Application
+	sApi        *api.SApi
+	sGrpc       *grpc.SGrpc
 }
 
 func NewPresentation(infrastructure *infrastructure.Infrastructure, application *application.Application) *Presentation {
 	return &Presentation{
-		sApi:  api.NewSApi(application, infrastructure.SConfig, infrastructure.IJwtServer, infrastructure.ILogger, infrastructure.ITracer),
-		sGrpc: grpc.NewSGrpc(application, infrastructure.SConfig, infrastructure.ILogger, infrastructure.ITracer),
+		application: application,
+		sApi:        api.NewSApi(application, infrastructure.SConfig, infrastructure.IJwtServer, infrastructure.ILogger, infrastructure.ITracer),
+		sGrpc:       grpc.NewSGrpc(application, infrastructure.SConfig, infrastructure.IJwtServer, infrastructure.ILogger, infrastructure.ITracer),
 	}
 }
 
@@ -25,6 +27,7 @@ func (r *Presentation) Setup() {
 }
 
 func (r *Presentation) Close() {
+	r.application.Readiness.Shutdown()
 	r.sApi.Stop()
 	r.sGrpc.Stop()
 }